@@ -3,14 +3,13 @@ package DateTimeMate
 import (
 	_ "embed"
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jftuga/DateTimeMate/internal/dtparse"
-	"github.com/lestrrat-go/strftime"
+	"github.com/jftuga/DateTimeMate/internal/reldate"
 )
 
 // ReadmeMd is the project README, embedded here (next to the file at the
@@ -34,6 +33,13 @@ const (
 // set, a warning naming the variable is written to stderr.
 const DateOrderEnvVar = "DTMATE_DATE_ORDER"
 
+// ParseLocalesEnvVar names the environment variable listing, comma
+// separated, the locales whose month names, weekday names, and relative
+// words are accepted in addition to English, such as "fr,de" for
+// "3 mars 2026" and "Dienstag, 3. März 2026", or "all" for every locale
+// that Locales returns. Unset or empty means English only.
+const ParseLocalesEnvVar = "DTMATE_PARSE_LOCALES"
+
 // this type of data structure is needed to preserve order as
 // replacement always needs to be performed in this order
 // otherwise you may get results such as:
@@ -48,6 +54,8 @@ var abbrevMap = [][]string{
 	{"hours", "h"},
 	{"days", "D"},
 	{"weeks", "W"},
+	{"months", "M"},
+	{"quarters", "Q"},
 	{"years", "Y"},
 	{"nanosecond", "ns"},
 	{"microsecond", "us"},
@@ -57,22 +65,32 @@ var abbrevMap = [][]string{
 	{"hour", "h"},
 	{"day", "D"},
 	{"week", "W"},
+	{"month", "M"},
+	{"quarter", "Q"},
 	{"year", "Y"},
 }
 
+// relativeWords maps the relative words to their offsets from now in
+// calendar days, which keep the wall clock across DST transitions
+var relativeWords = map[string]int{
+	"now":       0,
+	"today":     0,
+	"yesterday": -1,
+	"tomorrow":  1,
+}
+
 // ConvertRelativeDateToActual converts "yesterday", "today", "tomorrow"
-// into actual dates; yesterday and tomorrow are -/+ 24 hours of current time
+// into actual dates; yesterday and tomorrow are the same wall clock a
+// calendar day before or after the reference instant returned by Now
 func ConvertRelativeDateToActual(from string) string {
-	switch strings.ToLower(from) {
-	case "now", "today":
-		return time.Now().Format("2006-01-02 15:04:05")
-	case "yesterday":
-		// Add keeps the documented "exactly 24 hours" promise; AddDate
-		// is calendar-day arithmetic, which is 23 or 25 real hours on the
-		// two DST transition days
-		return time.Now().Add(-24 * time.Hour).Format("2006-01-02 15:04:05")
-	case "tomorrow":
-		return time.Now().Add(24 * time.Hour).Format("2006-01-02 15:04:05")
+	return convertRelativeDate(from, Now())
+}
+
+// convertRelativeDate is ConvertRelativeDateToActual relative to now,
+// rendered as a local wall clock
+func convertRelativeDate(from string, now time.Time) string {
+	if days, ok := relativeWords[strings.ToLower(from)]; ok {
+		return now.In(time.Local).AddDate(0, 0, days).Format("2006-01-02 15:04:05")
 	}
 	return from
 }
@@ -205,38 +223,50 @@ func slashDateFields(source string) (first, second, yearDigits int, ok bool) {
 var slashDateTimeSuffixes = []string{"", " 15:04:05", "T15:04:05", " 15:04", "T15:04", " 3:04:05PM", " 3:04PM", " 3:04:05pm", " 3:04pm", " 3:04:05 PM", " 3:04 PM", " 3:04:05 pm", " 3:04 pm"}
 
 // parseSlashDate parses slash-separated dates, whose field order the layered
-// parsers disagree on: month first (the default) or day first when
-// DateOrderEnvVar is set to "DMY". A field greater than 12 disambiguates on
-// its own, regardless of the variable; an ambiguous date parsed with the
-// variable unset triggers a stderr warning naming it. The claimed return
+// parsers disagree on: month first (the default) or day first when the
+// Parser's DateOrder, or else DateOrderEnvVar, is "DMY". A field greater than
+// 12 disambiguates on its own, regardless of either; an ambiguous date
+// parsed with neither set is warned about, or rejected by a strict Parser. The claimed return
 // reports whether the input has the slash-date shape at all; when true the
 // caller must not try other parsers, so that no shape can silently fall
-// through to a parser with a different field order.
-func parseSlashDate(source string, loc *time.Location) (time.Time, bool, error) {
+// through to a parser with a different field order. The Detection holds the
+// matched layout and how the field order was decided.
+func (p *Parser) parseSlashDate(source string, loc *time.Location) (time.Time, Detection, bool, error) {
+	d := Detection{Layer: LayerSlashDate, Kind: KindWallClock}
 	first, second, yearDigits, ok := slashDateFields(source)
 	if !ok {
-		return time.Time{}, false, nil
+		return time.Time{}, d, false, nil
 	}
 	var monthFirst bool
 	switch {
 	case first > 12 && second > 12:
-		return time.Time{}, true, fmt.Errorf("invalid date %q: neither %d nor %d can be a month", source, first, second)
+		return time.Time{}, d, true, fmt.Errorf("invalid date %q: neither %d nor %d can be a month", source, first, second)
 	case first > 12:
 		monthFirst = false
+		d.DateOrder = fmt.Sprintf("day/month/year: %d cannot be a month", first)
 	case second > 12:
 		monthFirst = true
+		d.DateOrder = fmt.Sprintf("month/day/year: %d cannot be a month", second)
 	default:
-		order := strings.ToUpper(strings.TrimSpace(os.Getenv(DateOrderEnvVar)))
-		switch order {
-		case "", "MDY":
-			if order == "" {
-				fmt.Fprintf(os.Stderr, "warning: %q is ambiguous: interpreting as month/day/year; set %s=DMY to override\n", source, DateOrderEnvVar)
-			}
-			monthFirst = true
-		case "DMY":
-			monthFirst = false
+		order, origin, err := p.dateOrder()
+		if err != nil {
+			return time.Time{}, d, true, err
+		}
+		monthFirst = order == DateOrderMDY
+		switch {
+		case origin != "" && monthFirst:
+			d.DateOrder = "month/day/year: ambiguous, set by " + origin
+		case origin != "":
+			d.DateOrder = "day/month/year: ambiguous, set by " + origin
+		case p.Strict:
+			return time.Time{}, d, true, fmt.Errorf("%w: %q is ambiguous: set a date order, MDY or DMY", ErrStrictParse, source)
 		default:
-			return time.Time{}, true, fmt.Errorf("%s must be MDY or DMY, not %q", DateOrderEnvVar, order)
+			override := "the parser's date order to DMY"
+			if p.fromEnv {
+				override = DateOrderEnvVar + "=DMY"
+			}
+			p.warn(&d, "%q is ambiguous: interpreting as month/day/year; set %s to override", source, override)
+			d.DateOrder = fmt.Sprintf("month/day/year: ambiguous, the default; set %s to override", override)
 		}
 	}
 	yearLayout := "2006"
@@ -248,15 +278,16 @@ func parseSlashDate(source string, loc *time.Location) (time.Time, bool, error)
 		dateLayout = "2/1/" + yearLayout
 	}
 	for _, suffix := range slashDateTimeSuffixes {
-		t, err := time.ParseInLocation(dateLayout+suffix, source, loc)
+		d.Layout = dateLayout + suffix
+		t, err := time.ParseInLocation(d.Layout, source, loc)
 		if err == nil {
-			return t, true, nil
+			return p.pivotYear(t, d.Layout), d, true, nil
 		}
 		if outOfRangeParseError(err) {
-			return time.Time{}, true, fmt.Errorf("invalid date/time %q: %v", source, err)
+			return time.Time{}, d, true, fmt.Errorf("invalid date/time %q: %v", source, err)
 		}
 	}
-	return time.Time{}, true, fmt.Errorf("unable to parse date/time: %q", source)
+	return time.Time{}, d, true, fmt.Errorf("unable to parse date/time: %q", source)
 }
 
 // outOfRangeParseError reports whether a time.Parse error means the input
@@ -289,53 +320,128 @@ func validateParsedZone(t time.Time) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("zone abbreviation %q is not resolvable in this input position", name)
 }
 
+// detectLocalized runs detect on source and, only when that fails, on its
+// English rewrite when source is a date, weekday, or relative word written
+// in one of the Parser's locales, such as "3 mars 2026" for "Mar 3, 2026";
+// English input is never rewritten, so a locale cannot change how input
+// that already parses is read
+func (p *Parser) detectLocalized(source string, detect func(string) (time.Time, Detection, error)) (time.Time, Detection, error) {
+	locales, err := p.locales()
+	if err != nil {
+		return time.Time{}, Detection{}, err
+	}
+	t, d, err := detect(source)
+	if err == nil || len(locales) == 0 {
+		return t, d, err
+	}
+	english, ok, lerr := dtparse.Localize(source, locales)
+	if lerr != nil {
+		return time.Time{}, Detection{}, fmt.Errorf("invalid date/time %q: %w", source, lerr)
+	}
+	if !ok {
+		return t, d, err
+	}
+	return detect(english)
+}
+
 // parseDateTime parses a date/time string, interpreting zone-less input in
 // the local time zone
 func parseDateTime(source string) (time.Time, error) {
 	return parseDateTimeIn(source, time.Local)
 }
 
-// parseDateTimeIn parses a date/time string in four layers: common zone-less
-// layouts in loc, then zone-carrying layouts (validated so a fabricated
-// zero-offset zone is repaired or rejected), then slash-separated dates
-// (whose field order is settled by DateOrderEnvVar and which claim their
-// shape exclusively), and finally the unified dtparse fallback table, whose
+// parseDateTimeIn parses a date/time string in six layers: ISO 8601 week
+// and ordinal dates such as "2026-W07-3" and "2026-045" (which claim their
+// shapes exclusively, before "2026-365" can be rejected as month 36), then
+// natural-language relative dates such as "next friday 9am", resolved
+// against the current time in loc, then common zone-less layouts in loc, then zone-carrying layouts (validated so
+// a fabricated zero-offset zone is repaired or rejected), then
+// slash-separated dates (whose field order is settled by DateOrderEnvVar and
+// which claim their shape exclusively), and finally the unified dtparse fallback table, whose
 // zoned results run the same zone validation as the second layer; every
 // layer rejects out-of-range components immediately so invalid input can
 // never fall through to a layer that would silently normalize it. loc is
 // the zone for all zone-less input, including the date stamped onto a bare
 // time of day, so "08:30 CET" means 08:30 on the current CET day even when
-// the local calendar day differs.
+// the local calendar day differs. Input written with the month names,
+// weekday names, or relative words of a ParseLocalesEnvVar locale is first
+// rewritten into English when it does not parse as written.
 func parseDateTimeIn(source string, loc *time.Location) (time.Time, error) {
+	t, _, err := defaultParser.detectDateTimeIn(source, loc)
+	return t, err
+}
+
+// detectDateTimeIn is parseDateTimeIn with the Parser's settings, also
+// reporting the layer, layout, and kind that read source
+func (p *Parser) detectDateTimeIn(source string, loc *time.Location) (time.Time, Detection, error) {
+	if err := p.validate(); err != nil {
+		return time.Time{}, Detection{}, err
+	}
+	return p.detectLocalized(source, func(source string) (time.Time, Detection, error) {
+		return p.detectEnglishDateTimeIn(source, loc)
+	})
+}
+
+// detectEnglishDateTimeIn is detectDateTimeIn without the localized
+// fallback
+func (p *Parser) detectEnglishDateTimeIn(source string, loc *time.Location) (time.Time, Detection, error) {
+	if t, claimed, err := parseISOWeekOrOrdinalDate(source, loc); claimed {
+		return t, isoDetection(source), err
+	}
+	now := p.now()
+	// a time of day alone, such as "9am", is read by the other layers first
+	// and only falls back to the relative reading when none of them can
+	clockOnly, clockOnlyOK := time.Time{}, false
+	if t, phrase, claimed, err := reldate.Parse(source, now.In(loc)); claimed && err == nil && reldate.TimeOfDayOnly(phrase) {
+		clockOnly, clockOnlyOK = t, true
+	} else if claimed {
+		d := Detection{Layer: LayerRelativeDate, Kind: KindWallClock}
+		if err == nil && p.Strict {
+			return time.Time{}, d, fmt.Errorf("%w: %q is relative to the current time", ErrStrictParse, source)
+		}
+		return t, d, err
+	}
 	for _, layout := range wallClockLayouts {
+		d := Detection{Layer: LayerWallClock, Layout: layout, Kind: KindWallClock}
 		t, err := time.ParseInLocation(layout, source, loc)
 		if err == nil {
-			return t, nil
+			return t, d, nil
 		}
 		if outOfRangeParseError(err) {
-			return time.Time{}, fmt.Errorf("invalid date/time %q: %v", source, err)
+			return time.Time{}, d, fmt.Errorf("invalid date/time %q: %v", source, err)
 		}
 	}
 	for _, layout := range zonedLayouts {
+		d := Detection{Layer: LayerZoned, Layout: layout, Kind: KindZoned}
 		t, err := time.Parse(layout, source)
 		if outOfRangeParseError(err) {
-			return time.Time{}, fmt.Errorf("invalid date/time %q: %v", source, err)
+			return time.Time{}, d, fmt.Errorf("invalid date/time %q: %v", source, err)
 		}
 		if err == nil {
-			return validateParsedZone(t)
+			t, err = validateParsedZone(t)
+			return t, d, err
 		}
 	}
-	if t, claimed, err := parseSlashDate(source, loc); claimed {
-		return t, err
+	if t, d, claimed, err := p.parseSlashDate(source, loc); claimed {
+		return t, d, err
 	}
-	t, kind, err := dtparse.Parse(source, loc)
-	if err != nil {
-		return time.Time{}, err
+	t, kind, layout, err := dtparse.ParseLayout(source, loc, now)
+	d := Detection{Layer: LayerFallback, Layout: layout, Kind: detectionKinds[kind]}
+	if err != nil && clockOnlyOK {
+		t, kind, layout = clockOnly, dtparse.KindTimeOnly, ""
+		d = Detection{Layer: LayerRelativeDate, Kind: KindTimeOnly}
+	} else if err != nil {
+		return time.Time{}, d, err
+	}
+	if kind == dtparse.KindTimeOnly && p.Strict {
+		return time.Time{}, d, fmt.Errorf("%w: %q has no date", ErrStrictParse, source)
 	}
 	if kind == dtparse.KindZoned {
-		return validateParsedZone(t)
+		if t, err = validateParsedZone(t); err != nil {
+			return time.Time{}, d, err
+		}
 	}
-	return t, nil
+	return p.pivotYear(t, layout), d, nil
 }
 
 // Reformat converts a date/time string into a specified format. The source can be:
@@ -344,41 +450,93 @@ func parseDateTimeIn(source string, loc *time.Location) (time.Time, error) {
 //   - Any other parseable date format
 //
 // The outputFormat parameter uses strftime format specifiers, with additional
-// support for Unix seconds via '%s'.
+// support for fractional seconds (%L, %f, %N, %3N, %6N, %9N), the quarter
+// (%Q), ordinal day suffixes (%o), and Unix time (%s, %3s, %6s, %9s).
+// FormatWithLocale localizes month and weekday names and the %c, %x, and
+// %X representations, and FormatWithInputZone reads a source without a
+// zone of its own in another zone than the local one.
 //
 // Example usage:
 //
 //	s, err := Reformat("1700265600", "%Y-%m-%d")         // Unix timestamp to date
 //	s, err := Reformat("yesterday", "%Y-%m-%d %H:%M:%S") // Relative date to datetime
 //	s, err := Reformat("2024-01-01", "%s")               // Date to Unix timestamp
+//	s, err := Reformat("2024-01-01", "%B %-d%o, Q%Q")    // January 1st, Q1
+//	s, err := Reformat("2024-01-01", "%A %-d %B", FormatWithLocale("fr")) // lundi 1 janvier
 //
 // Returns an error if:
 //   - The outputFormat is invalid
 //   - The source date cannot be parsed
 //   - The time parser initialization fails
-func Reformat(source string, outputFormat string) (string, error) {
-	t, err := parseDateTimeOrUnix(strings.TrimSpace(source))
+func Reformat(source string, outputFormat string, options ...OptionsFormat) (string, error) {
+	var settings formatSettings
+	for _, opt := range options {
+		opt(&settings)
+	}
+	p, err := settings.parser.withClock(settings.clock).orDefault().inZone(settings.inputZone)
 	if err != nil {
 		return "", err
 	}
-	return FormatTime(t, outputFormat)
+	t, err := p.parse(strings.TrimSpace(source))
+	if err != nil {
+		return "", err
+	}
+	return FormatTime(t, outputFormat, options...)
+}
+
+// FormatWithInputZone makes Reformat and DetectFormat read a source without
+// a zone of its own as a wall clock in zone: an IANA name, an abbreviation,
+// an alias from ZoneAliasesEnvVar, or a UTC offset, resolved as by
+// ResolveZone; the result is rendered in that zone. FormatTime, which does
+// no parsing, ignores it.
+func FormatWithInputZone(zone string) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.inputZone = zone
+	}
+}
+
+// FormatWithParser makes Reformat and DetectFormat read a source with the
+// Parser's settings instead of the environment's; FormatTime, which does no
+// parsing, ignores it
+func FormatWithParser(p *Parser) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.parser = p
+	}
+}
+
+// FormatWithClock sets the reference instant with which Reformat and
+// DetectFormat read a relative source such as "now" or "next friday";
+// FormatTime, which does no parsing, ignores it
+func FormatWithClock(c Clock) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.clock = c
+	}
 }
 
 // FormatTime renders an already-parsed time.Time using strftime format
-// specifiers, with additional support for Unix seconds via '%s'. Unlike
+// specifiers, with the same additional specifiers as Reformat, or with the
+// Go layout given by FormatWithLayout when outputFormat is empty. Unlike
 // Reformat it performs no parsing, so the time's location (and therefore
-// %Z, %z and %s) is preserved exactly.
+// %Z, %z and %s) is preserved exactly, unless FormatWithOutputZone converts
+// it first.
 //
-// Returns an error if the outputFormat is invalid.
-func FormatTime(t time.Time, outputFormat string) (string, error) {
-	// creates a new Strftime instance
-	// outputFormat is a pattern string that follows strftime formatting
-	// the additional formatting behavior allows this to also use the unix time %s modifier
-	f, err := strftime.New(outputFormat, strftime.WithUnixSeconds('s'))
+// Returns an error if the outputFormat is invalid, if it is combined with a
+// Go layout, or if the output zone cannot be resolved or converted to.
+func FormatTime(t time.Time, outputFormat string, options ...OptionsFormat) (string, error) {
+	format, ok, err := newFormatter(outputFormat, options...)
+	if err != nil || !ok {
+		return "", err
+	}
+	convert, err := newOutputConverter(options...)
 	if err != nil {
 		return "", err
 	}
-	return f.FormatString(t), nil
+	if convert != nil {
+		if t, err = convert(t); err != nil {
+			return "", err
+		}
+	}
+	return format(t), nil
 }
 
 // timestampDigits returns the number of digits in a pure integer string,
@@ -436,21 +594,15 @@ func isUnixTimestamp(s string) bool {
 }
 
 // parseIntegerDateTime parses a pure-integer date/time that is not a Unix
-// timestamp: 4 digits are a year, 8 digits a compact date, and 14 digits a
-// compact date/time, all interpreted in the given time zone; any other
+// timestamp: 4 digits are a year, 7 digits a compact ordinal date
+// ("2026045" is the 45th day of 2026), 8 digits a compact date, and 14
+// digits a compact date/time, all interpreted in the given time zone; any other
 // digit count errors rather than falling through to a parser that would
 // misread the digits as a time of day on the current date
 func parseIntegerDateTime(source string, loc *time.Location) (time.Time, error) {
-	var layout string
-	switch timestampDigits(source) {
-	case 4:
-		layout = "2006"
-	case 8:
-		layout = "20060102"
-	case 14:
-		layout = "20060102150405"
-	default:
-		return time.Time{}, fmt.Errorf("ambiguous integer date/time %q: expected 4 digits (year), 8 (date), 10 (seconds), 13 (milliseconds), or 14 (date/time)", source)
+	layout, err := integerLayout(source)
+	if err != nil {
+		return time.Time{}, err
 	}
 	t, err := time.ParseInLocation(layout, source, loc)
 	if err != nil {
@@ -459,6 +611,22 @@ func parseIntegerDateTime(source string, loc *time.Location) (time.Time, error)
 	return t, nil
 }
 
+// integerLayout returns the layout of a pure-integer date/time that is not a
+// Unix timestamp, by its digit count
+func integerLayout(source string) (string, error) {
+	switch timestampDigits(source) {
+	case 4:
+		return "2006", nil
+	case 7:
+		return "2006002", nil
+	case 8:
+		return "20060102", nil
+	case 14:
+		return "20060102150405", nil
+	}
+	return "", fmt.Errorf("ambiguous integer date/time %q: expected 4 digits (year), 7 (ordinal date), 8 (date), 10 (seconds), 13 (milliseconds), or 14 (date/time)", source)
+}
+
 // parseDateTimeOrUnix parses a date/time string, treating 10-digit (seconds)
 // and 13-digit (milliseconds) integers as Unix timestamps; negative integers
 // are rejected because timestamps can't be negative, other integers are
@@ -466,18 +634,73 @@ func parseIntegerDateTime(source string, loc *time.Location) (time.Time, error)
 // relative date and parsed as a date/time; empty input is rejected because
 // the lenient fallback parsers silently read it as the current time
 func parseDateTimeOrUnix(source string) (time.Time, error) {
+	return parseDateTimeOrUnixIn(source, time.Local)
+}
+
+// parseDateTimeOrUnixIn is parseDateTimeOrUnix with zone-less input
+// interpreted in loc; unix timestamps and relative dates name instants, so
+// they are only converted to loc. Date math expressions such as
+// "now-7d/d" are evaluated first, with their anchor parsed the same way.
+func parseDateTimeOrUnixIn(source string, loc *time.Location) (time.Time, error) {
+	t, _, err := defaultParser.detectDateTimeOrUnixIn(source, loc)
+	return t, err
+}
+
+// detectDateTimeOrUnixIn is parseDateTimeOrUnixIn with the Parser's
+// settings, also reporting the layer, layout, and kind that read source
+func (p *Parser) detectDateTimeOrUnixIn(source string, loc *time.Location) (time.Time, Detection, error) {
+	if err := p.validate(); err != nil {
+		return time.Time{}, Detection{}, err
+	}
 	source = strings.TrimSpace(source)
 	if source == "" {
-		return time.Time{}, ErrEmptyInput
+		return time.Time{}, Detection{}, ErrEmptyInput
+	}
+	return p.detectLocalized(source, func(source string) (time.Time, Detection, error) {
+		return p.detectEnglishDateTimeOrUnixIn(source, loc)
+	})
+}
+
+// detectEnglishDateTimeOrUnixIn is detectDateTimeOrUnixIn without the
+// localized fallback
+func (p *Parser) detectEnglishDateTimeOrUnixIn(source string, loc *time.Location) (time.Time, Detection, error) {
+	if t, anchor, claimed, err := p.detectDateMath(source, loc); claimed {
+		return t, Detection{Layer: LayerDateMath, AnchorLayer: anchor.Layer, Kind: anchor.Kind, Warning: anchor.Warning}, err
 	}
 	if isPureIntegerAtoi(source) {
 		if strings.HasPrefix(source, "-") {
-			return time.Time{}, fmt.Errorf("timestamps can't be negative: %v", source)
+			return time.Time{}, Detection{}, fmt.Errorf("timestamps can't be negative: %v", source)
+		}
+		if isUnixTimestamp(source) && !p.AllowUnix {
+			return time.Time{}, Detection{}, fmt.Errorf("unix timestamps are not allowed: %q", source)
 		}
 		if isUnixTimestamp(source) {
-			return unixStringToTime(source)
+			d := Detection{Layer: LayerUnixTimestamp, Kind: KindInstant, Strftime: "%s"}
+			if timestampDigits(source) == 13 {
+				d.Strftime = "%3s"
+			}
+			t, err := unixStringToTime(source)
+			if err != nil || loc == time.Local {
+				return t, d, err
+			}
+			return t.In(loc), d, nil
+		}
+		layout, err := integerLayout(source)
+		d := Detection{Layer: LayerCompactInteger, Layout: layout, Kind: KindWallClock}
+		if err != nil {
+			return time.Time{}, d, err
+		}
+		t, err := parseIntegerDateTime(source, loc)
+		return t, d, err
+	}
+	if days, ok := relativeWords[strings.ToLower(source)]; ok {
+		d := Detection{Layer: LayerRelativeWord, Kind: KindInstant}
+		if p.Strict {
+			return time.Time{}, d, fmt.Errorf("%w: %q is relative to the current time", ErrStrictParse, source)
 		}
-		return parseIntegerDateTime(source, time.Local)
+		now := p.now()
+		// whole seconds, as ConvertRelativeDateToActual renders them
+		return now.In(loc).AddDate(0, 0, days).Truncate(time.Second), d, nil
 	}
-	return parseDateTime(ConvertRelativeDateToActual(source))
+	return p.detectEnglishDateTimeIn(source, loc)
 }
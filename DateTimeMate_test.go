@@ -1,6 +1,7 @@
 package DateTimeMate
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 	"testing"
@@ -154,6 +155,77 @@ func TestSlashDateOrder(t *testing.T) {
 	}
 }
 
+func TestParseLocales(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	// unset, only English names are read
+	t.Setenv(ParseLocalesEnvVar, "")
+	if _, err := Reformat("3 mars 2026", "%F"); err == nil {
+		t.Error("expected an error for a French date with no parse locales, got nil")
+	}
+
+	t.Setenv(ParseLocalesEnvVar, "fr, de_DE.UTF-8,es")
+	testFormat(t, "3 mars 2026", "%F", "2026-03-03")
+	testFormat(t, "mardi 3 mars 2026 à 14:30", "%F %R", "2026-03-03 14:30")
+	testFormat(t, "Dienstag, den 3. März 2026 um 9:00", "%F %R", "2026-03-03 09:00")
+	testFormat(t, "3 de marzo de 2026", "%F", "2026-03-03")
+	testFormat(t, "Mar 3, 2026", "%F", "2026-03-03")
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	testFormat(t, "demain à 9:00", "%F %R", tomorrow+" 09:00")
+	testFormat(t, "mañana 9:00", "%F %R", tomorrow+" 09:00")
+
+	// every command's parser reads localized input
+	diff := NewDiff(DiffWithStart("2026-03-01"), DiffWithEnd("1 mars 2026 12:00"))
+	if got, _, err := diff.CalculateDiff(); err != nil || got != "12 hours" {
+		t.Errorf("CalculateDiff() = %q, %v; want 12 hours", got, err)
+	}
+
+	t.Setenv(ParseLocalesEnvVar, "all")
+	testFormat(t, "3 maart 2026", "%F", "2026-03-03")
+	testFormat(t, "Montag 9:00", "%A %R", "Monday 09:00")
+	if _, err := Reformat("mer 3 mars 2026", "%F"); err == nil || !strings.Contains(err.Error(), "not a Wednesday") {
+		t.Errorf("expected an error for a contradicting weekday, got %v", err)
+	}
+
+	t.Setenv(ParseLocalesEnvVar, "fr,xx")
+	if _, err := Reformat("3 mars 2026", "%F"); err == nil || !strings.Contains(err.Error(), ParseLocalesEnvVar) {
+		t.Errorf("expected an error naming %s for an unknown locale, got %v", ParseLocalesEnvVar, err)
+	}
+}
+
+func TestParseLocalesKeepZonedLayouts(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	// localization is a fallback, so a locale cannot reshape English input
+	// that already parses ("Tue, 03 Mar 2026 14:05:00 +0000" used to be
+	// rewritten into an unparsable "Tue, Mar 3, 2026 14:05:00 +0000"); zone
+	// abbreviations parse in the local zone, so the instant is local
+	instant := time.Date(2026, 3, 3, 14, 5, 0, 0, time.Local)
+	sources := []string{"Tue, 03 Mar 2026 14:05:00 +0000"}
+	for _, layout := range zonedLayouts {
+		sources = append(sources, instant.Format(layout))
+	}
+	t.Setenv(ParseLocalesEnvVar, "")
+	want := map[string]time.Time{}
+	for _, source := range sources {
+		if got, err := parseDateTime(source); err == nil {
+			want[source] = got
+		}
+	}
+	for _, locales := range []string{"en", "all"} {
+		t.Setenv(ParseLocalesEnvVar, locales)
+		for _, source := range sources {
+			got, err := parseDateTime(source)
+			if w, ok := want[source]; ok && (err != nil || !got.Equal(w)) {
+				t.Errorf("%s=%s: parseDateTime(%q) = %v, %v; want %v as with no locales", ParseLocalesEnvVar, locales, source, got, err, w)
+			}
+		}
+	}
+	if got := want["Tue, 03 Mar 2026 14:05:00 +0000"]; !got.Equal(time.Date(2026, 3, 3, 14, 5, 0, 0, time.UTC)) {
+		t.Errorf("parseDateTime of RFC 1123 with a numeric zone = %v", got)
+	}
+	t.Setenv(ParseLocalesEnvVar, "all")
+	testFormat(t, "3 mars 2026", "%F", "2026-03-03")
+}
+
 func TestParserAgreement(t *testing.T) {
 	// no t.Parallel: TestSlashDateOrder mutates the date-order variable
 	// Diff and Dur/Reformat must assign the same instant to the same input
@@ -211,8 +283,8 @@ func TestExplicitZonePreservedAcrossDST(t *testing.T) {
 
 func TestShrinkPeriod(t *testing.T) {
 	// explicitly define these here as a sanity check vs just iterating through abbrevMap
-	var allLongPeriods = []string{"nanoseconds", "microseconds", "milliseconds", "seconds", "minutes", "hours", "days", "weeks", "years"}
-	var allShortPeriods = []string{"ns", "us", "ms", "s", "m", "h", "D", "W", "Y"}
+	var allLongPeriods = []string{"nanoseconds", "microseconds", "milliseconds", "seconds", "minutes", "hours", "days", "weeks", "months", "quarters", "years"}
+	var allShortPeriods = []string{"ns", "us", "ms", "s", "m", "h", "D", "W", "M", "Q", "Y"}
 
 	for i, period := range allLongPeriods {
 		shrunk := shrinkPeriod(period)
@@ -308,22 +380,81 @@ func TestParseDateTimePre1970(t *testing.T) {
 	}
 }
 
-func TestRelativeDatesExactly24Hours(t *testing.T) {
-	// yesterday and tomorrow are exactly -/+ 24 hours of the current time,
-	// as documented, even across DST transitions (calendar-day arithmetic
-	// would be 23 or 25 real hours on the two transition days); the
-	// expansions come from separate Now() calls and the strings are
-	// second-granular, so allow a small tolerance
-	yesterday, err := parseDateTime(ConvertRelativeDateToActual("yesterday"))
+func TestRelativeDatesAreCalendarDays(t *testing.T) {
+	t.Parallel()
+	// yesterday and tomorrow move the calendar date and keep the wall
+	// clock, as "1 day ago" does, so on the day DST starts yesterday is 23
+	// real hours before noon, not 24
+	loc, err := time.LoadLocation("America/New_York")
 	if err != nil {
 		t.Fatal(err)
 	}
-	tomorrow, err := parseDateTime(ConvertRelativeDateToActual("tomorrow"))
-	if err != nil {
-		t.Fatal(err)
+	ref := time.Date(2026, 3, 8, 12, 0, 0, 0, loc)
+	p := NewParser(ParserWithClock(FixedClock(ref)), ParserWithLocation(loc))
+	for word, shift := range map[string]string{"yesterday": "1 day ago", "tomorrow": "1 day from now"} {
+		got, _, err := p.Parse(word)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _, err := p.Parse(shift)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) || got.Format("15:04 MST") != want.Format("15:04 MST") {
+			t.Errorf("Parse(%q) = %v, want %v, as %q", word, got, want, shift)
+		}
+	}
+	if got, _, _ := p.Parse("yesterday"); got.Format("2006-01-02 15:04 MST") != "2026-03-07 12:00 EST" {
+		t.Errorf("Parse(yesterday) on the DST start day = %v, want 2026-03-07 12:00 EST", got)
+	}
+	if got, _, _ := ParseRelativeDate("yesterday 9am", ref); got.Format("2006-01-02 15:04 MST") != "2026-03-07 09:00 EST" {
+		t.Errorf("ParseRelativeDate(yesterday 9am) = %v, want 2026-03-07 09:00 EST", got)
+	}
+	if got := convertRelativeDate("tomorrow", ref); got != ref.In(time.Local).AddDate(0, 0, 1).Format("2006-01-02 15:04:05") {
+		t.Errorf("convertRelativeDate(tomorrow) = %q", got)
+	}
+}
+
+func TestReformatWithInputZone(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct{ source, zone, want string }{
+		{"2026-07-01 12:00", "Asia/Tokyo", "2026-07-01 12:00 +0900"},
+		{"2026-07-01 12:00", "CET", "2026-07-01 12:00 +0100"},
+		{"2026-07-01 12:00", "-18000", "2026-07-01 12:00 -0500"},
+		{"2026-07-01T12:00:00Z", "Asia/Tokyo", "2026-07-01 12:00 +0000"},
+	} {
+		got, err := Reformat(tt.source, "%F %H:%M %z", FormatWithInputZone(tt.zone))
+		if err != nil || got != tt.want {
+			t.Errorf("Reformat(%q) in %s = %q, %v, want %q", tt.source, tt.zone, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormatWithOutputZone(t *testing.T) {
+	t.Setenv(ZoneAliasesEnvVar, "HQ=Asia/Kolkata")
+	noon := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	for zone, want := range map[string]string{
+		"Asia/Tokyo": "2026-07-01 21:00 +0900",
+		"hq":         "2026-07-01 17:30 +0530",
+		"-18000":     "2026-07-01 07:00 -0500",
+	} {
+		got, err := FormatTime(noon, "%F %H:%M %z", FormatWithOutputZone(zone))
+		if err != nil || got != want {
+			t.Errorf("FormatTime in %s = %q, %v, want %q", zone, got, err, want)
+		}
+	}
+	got, err := Reformat("2026-07-01 12:00", "", FormatWithLayout(time.RFC3339), FormatWithInputZone("America/New_York"), FormatWithOutputZone("Europe/London"))
+	if want := "2026-07-01T17:00:00+01:00"; err != nil || got != want {
+		t.Errorf("Reformat from New York to London = %q, %v, want %q", got, err, want)
+	}
+	if _, err := Reformat("1965-01-01", "%F", FormatWithOutputZone("UTC")); !errors.Is(err, ErrPre1970) {
+		t.Errorf("Reformat before 1970: err = %v, want ErrPre1970", err)
+	}
+	allow := NewTimeZoneConverter(TimeZoneConverterWithAllowPre1970(true))
+	if got, err := Reformat("1965-01-01T00:00:00Z", "%F %z", FormatWithOutputZone("3600"), FormatWithTimeZoneConverter(allow)); err != nil || got != "1965-01-01 +0100" {
+		t.Errorf("Reformat before 1970 with AllowPre1970 = %q, %v", got, err)
 	}
-	span := tomorrow.Sub(yesterday)
-	if span < 48*time.Hour-2*time.Second || span > 48*time.Hour+2*time.Second {
-		t.Errorf("yesterday to tomorrow spans %v, expected 48h", span)
+	if _, err := FormatTime(noon, "%F", FormatWithOutputZone("Nowhere/Zone")); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("FormatTime in an unknown zone: err = %v, want ErrInvalidTimezone", err)
 	}
 }
@@ -0,0 +1,112 @@
+// business.go implements business-day arithmetic for dur: the weekend
+// definition and the holiday set that together decide which calendar days
+// count as business days.
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/holiday"
+)
+
+// HolidayChecker reports whether a date is a holiday; business-day
+// arithmetic skips every date it reports, in addition to the weekend
+type HolidayChecker interface {
+	IsHoliday(t time.Time) bool
+}
+
+// HolidayDates is a HolidayChecker over a fixed set of calendar dates,
+// keyed as "2006-01-02"; a date matches regardless of its time of day
+type HolidayDates map[string]bool
+
+// NewHolidayDates parses each date with the same parser every sub-command
+// uses and returns them as a HolidayDates set
+func NewHolidayDates(dates ...string) (HolidayDates, error) {
+	set := make(HolidayDates, len(dates))
+	for _, date := range dates {
+		t, err := parseDateTimeOrUnix(date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %q: %w", date, err)
+		}
+		set[t.Format(time.DateOnly)] = true
+	}
+	return set, nil
+}
+
+// IsHoliday reports whether t's calendar date, in t's own location, is in
+// the set
+func (h HolidayDates) IsHoliday(t time.Time) bool {
+	return h[t.Format(time.DateOnly)]
+}
+
+// DefaultWeekend is the weekend used when none is configured
+var DefaultWeekend = []time.Weekday{time.Saturday, time.Sunday}
+
+// ParseWeekend parses a weekend definition: comma-separated weekday names
+// and wrapping ranges, such as "sat,sun", "fri-sat", or "fri,sat"; names
+// are full or abbreviated and case-insensitive. A weekend covering all
+// seven days is rejected because no business day would remain.
+func ParseWeekend(spec string) ([]time.Weekday, error) {
+	days, err := parseWeekdays(spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("empty weekend definition: %q", spec)
+	}
+	if len(days) == 7 {
+		return nil, fmt.Errorf("weekend %q covers every day, leaving no business days", spec)
+	}
+	return days, nil
+}
+
+// parseWeekdays parses comma-separated weekday names and wrapping ranges,
+// such as "mon-fri" or "fri-sun,tue", returning each day once in the order
+// first named
+func parseWeekdays(spec string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	seen := make(map[time.Weekday]bool)
+	for _, item := range strings.Split(spec, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(item, "-")
+		from, err := holiday.ParseWeekday(first)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			if to, err = holiday.ParseWeekday(last); err != nil {
+				return nil, err
+			}
+		}
+		for day := from; ; day = (day + 1) % 7 {
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day)
+			}
+			if day == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// isBusinessDay reports whether t falls outside the weekend and is not a
+// holiday; a nil weekend means DefaultWeekend and nil holidays means none
+func isBusinessDay(t time.Time, weekend []time.Weekday, holidays HolidayChecker) bool {
+	if weekend == nil {
+		weekend = DefaultWeekend
+	}
+	for _, day := range weekend {
+		if t.Weekday() == day {
+			return false
+		}
+	}
+	return holidays == nil || !holidays.IsHoliday(t)
+}
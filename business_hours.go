@@ -0,0 +1,338 @@
+// business_hours.go implements business-hours arithmetic: a weekly
+// schedule of working periods in a time zone, minus holidays, that counts
+// the working time between two instants and finds the instant a given
+// amount of working time after (or before) a start, such as an SLA
+// deadline.
+
+package DateTimeMate
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBusinessHours is the schedule used when none is given
+const DefaultBusinessHours = "mon-fri 09:00-17:00"
+
+// ErrNoBusinessHours is returned when a schedule has no working time
+var ErrNoBusinessHours = errors.New("business-hours schedule has no working time")
+
+// maxIdleScheduleDays bounds the consecutive days without working time
+// BusinessSchedule.Add will step over before giving up, so holidays that
+// cover every remaining working day cannot loop forever
+const maxIdleScheduleDays = 366
+
+// BusinessHours is one working period within a day, as offsets from
+// midnight on the wall clock; End may be 24 hours, the following midnight
+type BusinessHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// BusinessWeek holds each weekday's working periods, indexed by
+// time.Weekday and sorted by start
+type BusinessWeek [7][]BusinessHours
+
+// empty reports whether no weekday has a working period
+func (w BusinessWeek) empty() bool {
+	for _, periods := range w {
+		if len(periods) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// BusinessSchedule is a weekly working schedule: Week gives the working
+// periods of each weekday on the wall clock of Location, and no time is
+// worked on the dates Holidays reports
+type BusinessSchedule struct {
+	Week     BusinessWeek
+	Location *time.Location
+	Holidays HolidayChecker
+}
+
+type OptionsBusinessSchedule func(*BusinessSchedule)
+
+// NewBusinessSchedule returns a BusinessSchedule configured with the given
+// options; without them it works DefaultBusinessHours in the local time
+// zone, with no holidays
+func NewBusinessSchedule(options ...OptionsBusinessSchedule) *BusinessSchedule {
+	week, _ := ParseBusinessWeek(DefaultBusinessHours)
+	s := &BusinessSchedule{Week: week, Location: time.Local}
+	for _, opt := range options {
+		opt(s)
+	}
+	return s
+}
+
+// BusinessScheduleWithWeek sets the weekly working periods, as returned by
+// ParseBusinessWeek
+func BusinessScheduleWithWeek(week BusinessWeek) OptionsBusinessSchedule {
+	return func(s *BusinessSchedule) {
+		s.Week = week
+	}
+}
+
+// BusinessScheduleWithLocation sets the time zone whose wall clock the
+// working periods follow
+func BusinessScheduleWithLocation(loc *time.Location) OptionsBusinessSchedule {
+	return func(s *BusinessSchedule) {
+		s.Location = loc
+	}
+}
+
+// BusinessScheduleWithHolidays sets the dates on which no time is worked
+func BusinessScheduleWithHolidays(holidays HolidayChecker) OptionsBusinessSchedule {
+	return func(s *BusinessSchedule) {
+		s.Holidays = holidays
+	}
+}
+
+// String renders the schedule as ParseBusinessWeek accepts it, followed by
+// its time zone, e.g. "mon-fri 09:00-17:00 America/Chicago"; consecutive
+// days, Monday through Sunday, with the same periods share a group
+func (s *BusinessSchedule) String() string {
+	week := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+	dayName := func(day time.Weekday) string {
+		return strings.ToLower(day.String()[:3])
+	}
+	var groups []string
+	for i := 0; i < len(week); {
+		periods := s.Week[week[i]]
+		j := i + 1
+		for j < len(week) && slices.Equal(s.Week[week[j]], periods) {
+			j++
+		}
+		if len(periods) > 0 {
+			days := dayName(week[i])
+			if j-i > 1 {
+				days += "-" + dayName(week[j-1])
+			}
+			var clocks []string
+			for _, p := range periods {
+				clocks = append(clocks, formatClock(p.Start)+"-"+formatClock(p.End))
+			}
+			groups = append(groups, days+" "+strings.Join(clocks, ","))
+		}
+		i = j
+	}
+	return strings.Join(groups, "; ") + " " + s.location().String()
+}
+
+// ParseBusinessWeek parses a weekly schedule: semicolon-separated groups of
+// weekdays and comma-separated working periods, such as
+// "mon-fri 09:00-17:00" or "mon-thu 08:00-12:00,13:00-17:00; fri 08:00-12:00".
+// Days take the same names and ranges as ParseWeekend; times are HH:MM or
+// HH on the 24-hour clock, and a period may end at 24:00. Periods on the
+// same day must not overlap.
+func ParseBusinessWeek(spec string) (BusinessWeek, error) {
+	var week BusinessWeek
+	for _, group := range strings.Split(spec, ";") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		daySpec, periodSpec, found := strings.Cut(group, " ")
+		if !found || strings.TrimSpace(periodSpec) == "" {
+			return week, fmt.Errorf("invalid business hours %q: expected days and periods, such as: %s", group, DefaultBusinessHours)
+		}
+		days, err := parseWeekdays(daySpec)
+		if err != nil {
+			return week, fmt.Errorf("invalid business hours %q: %w", group, err)
+		}
+		for _, item := range strings.Split(periodSpec, ",") {
+			period, err := parseBusinessHours(strings.TrimSpace(item))
+			if err != nil {
+				return week, err
+			}
+			for _, day := range days {
+				week[day] = append(week[day], period)
+			}
+		}
+	}
+	for day := range week {
+		slices.SortFunc(week[day], func(a, b BusinessHours) int {
+			return int(a.Start - b.Start)
+		})
+		for i := 1; i < len(week[day]); i++ {
+			if week[day][i].Start < week[day][i-1].End {
+				return week, fmt.Errorf("invalid business hours %q: periods overlap on %s", spec, time.Weekday(day))
+			}
+		}
+	}
+	if week.empty() {
+		return week, fmt.Errorf("invalid business hours %q: %w", spec, ErrNoBusinessHours)
+	}
+	return week, nil
+}
+
+// parseBusinessHours parses one working period, such as "09:00-17:00"
+func parseBusinessHours(spec string) (BusinessHours, error) {
+	first, last, found := strings.Cut(spec, "-")
+	if !found {
+		return BusinessHours{}, fmt.Errorf("invalid business hours period %q: expected start-end, such as 09:00-17:00", spec)
+	}
+	start, err := parseClock(first)
+	if err != nil {
+		return BusinessHours{}, fmt.Errorf("invalid business hours period %q: %w", spec, err)
+	}
+	end, err := parseClock(last)
+	if err != nil {
+		return BusinessHours{}, fmt.Errorf("invalid business hours period %q: %w", spec, err)
+	}
+	if end <= start {
+		return BusinessHours{}, fmt.Errorf("invalid business hours period %q: the end must be after the start; split overnight periods at 24:00", spec)
+	}
+	return BusinessHours{Start: start, End: end}, nil
+}
+
+// parseClock parses a time of day, HH:MM or HH, as an offset from
+// midnight; 24:00 is the following midnight
+func parseClock(clock string) (time.Duration, error) {
+	clock = strings.TrimSpace(clock)
+	hh, mm, hasMinutes := strings.Cut(clock, ":")
+	hours, err := strconv.Atoi(hh)
+	minutes := 0
+	if err == nil && hasMinutes {
+		minutes, err = strconv.Atoi(mm)
+	}
+	if err != nil || hours < 0 || minutes < 0 || minutes > 59 || hours > 24 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("invalid time of day %q: expected HH:MM from 00:00 to 24:00", clock)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+// formatClock renders an offset from midnight as HH:MM
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// location returns the schedule's location, or time.Local when unset
+func (s *BusinessSchedule) location() *time.Location {
+	if s.Location == nil {
+		return time.Local
+	}
+	return s.Location
+}
+
+// workPeriod is one working period resolved to instants on a given date
+type workPeriod struct {
+	start, end time.Time
+}
+
+// periods returns the working periods on the given civil date in the
+// schedule's location; the wall clock is resolved with time.Date, so a
+// period spanning a DST change is as long as the time that really passes
+func (s *BusinessSchedule) periods(year int, month time.Month, day int) []workPeriod {
+	loc := s.location()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if s.Holidays != nil && s.Holidays.IsHoliday(midnight) {
+		return nil
+	}
+	var periods []workPeriod
+	for _, p := range s.Week[midnight.Weekday()] {
+		periods = append(periods, workPeriod{
+			start: time.Date(year, month, day, 0, 0, 0, int(p.Start), loc),
+			end:   time.Date(year, month, day, 0, 0, 0, int(p.End), loc),
+		})
+	}
+	return periods
+}
+
+// Elapsed returns the working time between start and end; it is negative
+// when end precedes start
+func (s *BusinessSchedule) Elapsed(start, end time.Time) time.Duration {
+	if end.Before(start) {
+		return -s.Elapsed(end, start)
+	}
+	var total time.Duration
+	year, month, day := start.In(s.location()).Date()
+	for ; ; day++ {
+		date := time.Date(year, month, day, 0, 0, 0, 0, s.location())
+		if !date.Before(end) {
+			break
+		}
+		for _, p := range s.periods(year, month, day) {
+			from, to := p.start, p.end
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			if to.After(from) {
+				total += to.Sub(from)
+			}
+		}
+	}
+	return total
+}
+
+// Add returns the instant d of working time after t, or before t when d is
+// negative, in t's location. A result landing exactly on the end of a
+// working period is that end, not the start of the next period. It fails
+// with ErrNoBusinessHours when the schedule has no working periods, or
+// when a year passes without any working time.
+func (s *BusinessSchedule) Add(t time.Time, d time.Duration) (time.Time, error) {
+	if s.Week.empty() {
+		return t, ErrNoBusinessHours
+	}
+	if d == 0 {
+		return t, nil
+	}
+	sign := 1
+	if d < 0 {
+		sign, d = -1, -d
+	}
+	loc := t.Location()
+	year, month, day := t.In(s.location()).Date()
+	for idle := 0; idle <= maxIdleScheduleDays; day += sign {
+		periods := s.periods(year, month, day)
+		if len(periods) == 0 {
+			idle++
+			continue
+		}
+		idle = 0
+		if sign < 0 {
+			slices.Reverse(periods)
+		}
+		for _, p := range periods {
+			var available time.Duration
+			if sign > 0 {
+				if p.start.After(t) {
+					t = p.start
+				}
+				available = p.end.Sub(t)
+			} else {
+				if p.end.Before(t) {
+					t = p.end
+				}
+				available = t.Sub(p.start)
+			}
+			if available <= 0 {
+				continue
+			}
+			if d <= available {
+				return t.Add(time.Duration(sign) * d).In(loc), nil
+			}
+			d -= available
+		}
+	}
+	return t, fmt.Errorf("%w within %d days of %s", ErrNoBusinessHours, maxIdleScheduleDays, t.Format(time.DateOnly))
+}
+
+// formatBusinessDuration renders working time in hours, minutes, and
+// seconds, since a day of working time is not 24 hours; sub-second units
+// are added only when the duration has a sub-second remainder
+func formatBusinessDuration(d time.Duration) string {
+	units := []string{"hours", "minutes", "seconds"}
+	if d%time.Second != 0 {
+		units = append(units, "milliseconds", "microseconds", "nanoseconds")
+	}
+	return (&Conv{}).formatTarget(int64(d), units)
+}
@@ -0,0 +1,209 @@
+package DateTimeMate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mustSchedule returns a schedule for spec in the named zone
+func mustSchedule(t *testing.T, spec, zone string, holidays HolidayChecker) *BusinessSchedule {
+	t.Helper()
+	week, err := ParseBusinessWeek(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewBusinessSchedule(BusinessScheduleWithWeek(week), BusinessScheduleWithLocation(loc), BusinessScheduleWithHolidays(holidays))
+}
+
+// at returns the wall clock "2006-01-02 15:04" in the schedule's location
+func at(t *testing.T, s *BusinessSchedule, wall string) time.Time {
+	t.Helper()
+	tm, err := time.ParseInLocation("2006-01-02 15:04", wall, s.Location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tm
+}
+
+func TestParseBusinessWeek(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"mon-fri 09:00-17:00", "mon-fri 09:00-17:00"},
+		{"Mon-Fri 9-17", "mon-fri 09:00-17:00"},
+		{"mon-thu 13:00-17:00,08:00-12:00; fri 08:00-12:00", "mon-thu 08:00-12:00,13:00-17:00; fri 08:00-12:00"},
+		{"sat-sun 00:00-24:00", "sat-sun 00:00-24:00"},
+		{"mon,wed 10:00-11:30", "mon 10:00-11:30; wed 10:00-11:30"},
+	}
+	for _, tt := range tests {
+		week, err := ParseBusinessWeek(tt.spec)
+		if err != nil {
+			t.Errorf("ParseBusinessWeek(%q): %v", tt.spec, err)
+			continue
+		}
+		got := NewBusinessSchedule(BusinessScheduleWithWeek(week), BusinessScheduleWithLocation(time.UTC)).String()
+		if got != tt.want+" UTC" {
+			t.Errorf("ParseBusinessWeek(%q) renders as %q, want %q", tt.spec, got, tt.want+" UTC")
+		}
+	}
+	for _, spec := range []string{"", "mon-fri", "mon-fri 17:00-09:00", "mon-fri 09:00-25:00", "mon-fri 09:60-17:00",
+		"someday 09:00-17:00", "mon-fri 09:00-12:00,11:00-17:00", "mon-fri 09:00"} {
+		if _, err := ParseBusinessWeek(spec); err == nil {
+			t.Errorf("ParseBusinessWeek(%q): expected an error, got nil", spec)
+		}
+	}
+}
+
+func TestBusinessScheduleElapsed(t *testing.T) {
+	t.Parallel()
+	holidays, err := NewHolidayDates("2026-11-26")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := mustSchedule(t, DefaultBusinessHours, "America/Chicago", holidays)
+	tests := []struct {
+		name       string
+		start, end string
+		want       time.Duration
+	}{
+		{"within one period", "2026-10-16 10:00", "2026-10-16 12:30", 150 * time.Minute},
+		{"across a weekend", "2026-10-16 15:00", "2026-10-20 10:00", 11 * time.Hour},
+		{"outside hours only", "2026-10-16 18:00", "2026-10-19 08:00", 0},
+		{"starting before hours", "2026-10-19 06:00", "2026-10-19 10:00", time.Hour},
+		{"skipping a holiday", "2026-11-25 15:00", "2026-11-27 10:00", 3 * time.Hour},
+		{"end before start", "2026-10-20 10:00", "2026-10-16 15:00", -11 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := s.Elapsed(at(t, s, tt.start), at(t, s, tt.end)); got != tt.want {
+			t.Errorf("%s: Elapsed(%s, %s) = %v, want %v", tt.name, tt.start, tt.end, got, tt.want)
+		}
+	}
+	// the instants are compared, not the wall clocks: 10:00 in New York is
+	// 09:00 in Chicago
+	ny, _ := time.LoadLocation("America/New_York")
+	start := time.Date(2026, 10, 19, 10, 0, 0, 0, ny)
+	if got := s.Elapsed(start, start.Add(3*time.Hour)); got != 3*time.Hour {
+		t.Errorf("Elapsed from New York time = %v, want 3h", got)
+	}
+}
+
+func TestBusinessScheduleElapsedAcrossDST(t *testing.T) {
+	t.Parallel()
+	// 2026-11-01 is a Sunday with a 25-hour day in New York
+	s := mustSchedule(t, "sun 00:00-24:00", "America/New_York", nil)
+	if got := s.Elapsed(at(t, s, "2026-10-31 12:00"), at(t, s, "2026-11-02 12:00")); got != 25*time.Hour {
+		t.Errorf("Elapsed across fall back = %v, want 25h", got)
+	}
+}
+
+func TestBusinessScheduleAdd(t *testing.T) {
+	t.Parallel()
+	s := mustSchedule(t, "mon-fri 08:00-12:00,13:00-17:00", "America/Chicago", nil)
+	tests := []struct {
+		name  string
+		start string
+		d     time.Duration
+		want  string
+	}{
+		{"within a period", "2026-10-16 09:00", 2 * time.Hour, "2026-10-16 11:00"},
+		{"over lunch", "2026-10-16 11:00", 2 * time.Hour, "2026-10-16 14:00"},
+		{"landing on the end of a period", "2026-10-16 15:00", 2 * time.Hour, "2026-10-16 17:00"},
+		{"over a weekend", "2026-10-16 15:00", 16 * time.Hour, "2026-10-20 15:00"},
+		{"starting outside hours", "2026-10-17 20:00", time.Hour, "2026-10-19 09:00"},
+		{"zero", "2026-10-17 20:00", 0, "2026-10-17 20:00"},
+		{"backward over lunch", "2026-10-16 14:00", -2 * time.Hour, "2026-10-16 11:00"},
+		{"backward over a weekend", "2026-10-19 09:00", -2 * time.Hour, "2026-10-16 16:00"},
+	}
+	for _, tt := range tests {
+		got, err := s.Add(at(t, s, tt.start), tt.d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := at(t, s, tt.want); !got.Equal(want) {
+			t.Errorf("%s: Add(%s, %v) = %v, want %v", tt.name, tt.start, tt.d, got, want)
+		}
+	}
+
+	// the result stays in the start's location: 17:00 in New York is 16:00
+	// in Chicago, leaving one working hour on Friday
+	ny, _ := time.LoadLocation("America/New_York")
+	got, err := s.Add(time.Date(2026, 10, 16, 17, 0, 0, 0, ny), 2*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 10, 19, 10, 0, 0, 0, ny); got.Location() != ny || !got.Equal(want) {
+		t.Errorf("Add from New York time = %v, want %v", got, want)
+	}
+}
+
+func TestBusinessScheduleAddNoWorkingTime(t *testing.T) {
+	t.Parallel()
+	s := NewBusinessSchedule(BusinessScheduleWithWeek(BusinessWeek{}))
+	if _, err := s.Add(time.Now(), time.Hour); !errors.Is(err, ErrNoBusinessHours) {
+		t.Errorf("expected ErrNoBusinessHours for an empty week, got: %v", err)
+	}
+	everyDay := holidayFunc(func(time.Time) bool { return true })
+	s = NewBusinessSchedule(BusinessScheduleWithHolidays(everyDay))
+	if _, err := s.Add(time.Now(), time.Hour); !errors.Is(err, ErrNoBusinessHours) {
+		t.Errorf("expected ErrNoBusinessHours when every day is a holiday, got: %v", err)
+	}
+}
+
+// holidayFunc adapts a function to a HolidayChecker
+type holidayFunc func(time.Time) bool
+
+func (f holidayFunc) IsHoliday(t time.Time) bool { return f(t) }
+
+func TestDiffBusinessHours(t *testing.T) {
+	t.Parallel()
+	s := mustSchedule(t, DefaultBusinessHours, "Local", nil)
+	diff := NewDiff(DiffWithStart("2026-10-16 15:00"), DiffWithEnd("2026-10-20 10:30"), DiffWithBusinessSchedule(s))
+	got, duration, err := diff.CalculateDiff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a working day is 8 hours, so the result is never rolled into days
+	if got != "11 hours 30 minutes" || duration != 11*time.Hour+30*time.Minute {
+		t.Errorf("CalculateDiff = %q, %v", got, duration)
+	}
+	diff = NewDiff(DiffWithStart("2026-10-20 10:30"), DiffWithEnd("2026-10-16 15:00"), DiffWithBusinessSchedule(s), DiffWithBrief(true))
+	if got, _, _ := diff.CalculateDiff(); got != "-11h30m" {
+		t.Errorf("reversed brief CalculateDiff = %q, want -11h30m", got)
+	}
+}
+
+func TestDurBusinessHours(t *testing.T) {
+	t.Parallel()
+	s := mustSchedule(t, DefaultBusinessHours, "Local", nil)
+	dur := NewDur(DurWithFrom("2026-10-16 15:00"), DurWithDur("16h"), DurWithRepeat(2),
+		DurWithBusinessSchedule(s), DurWithOutputFormat("%F %H:%M"))
+	got, err := dur.Add()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"2026-10-20 15:00", "2026-10-22 15:00"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Add = %v, want %v", got, want)
+	}
+	got, err = NewDur(DurWithFrom("2026-10-19 10:00"), DurWithDur("1.5 hours"),
+		DurWithBusinessSchedule(s), DurWithOutputFormat("%F %H:%M")).Sub()
+	if err != nil || len(got) != 1 || got[0] != "2026-10-16 16:30" {
+		t.Errorf("Sub = %v, %v, want [2026-10-16 16:30]", got, err)
+	}
+	for _, period := range []string{"1D", "1 week", "2B"} {
+		_, err := NewDur(DurWithFrom("2026-10-16"), DurWithDur(period), DurWithBusinessSchedule(s)).Add()
+		if err == nil || !strings.Contains(err.Error(), "working time") {
+			t.Errorf("period %q: expected a working-time error, got: %v", period, err)
+		}
+		if strings.Contains(err.Error(), "businessday") {
+			t.Errorf("period %q: error names the internal unit key: %v", period, err)
+		}
+	}
+}
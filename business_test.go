@@ -0,0 +1,134 @@
+// business_test.go verifies the business-day building blocks: weekend
+// definitions, holiday date sets, and the business-day unit in dur with
+// the default and custom weekends, holidays, repeat, and until.
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWeekend(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		spec string
+		want []time.Weekday
+	}{
+		{"sat,sun", []time.Weekday{time.Saturday, time.Sunday}},
+		{"Fri,Sat", []time.Weekday{time.Friday, time.Saturday}},
+		{"fri-sat", []time.Weekday{time.Friday, time.Saturday}},
+		{"friday", []time.Weekday{time.Friday}},
+		// ranges wrap around the end of the week
+		{"fri-sun", []time.Weekday{time.Friday, time.Saturday, time.Sunday}},
+		{"sat,sat-sun", []time.Weekday{time.Saturday, time.Sunday}},
+	}
+	for _, tt := range tests {
+		got, err := ParseWeekend(tt.spec)
+		if err != nil {
+			t.Errorf("ParseWeekend(%q): %v", tt.spec, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ParseWeekend(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+	for _, spec := range []string{"", "caturday", "mon-sun", "sat-fri"} {
+		if _, err := ParseWeekend(spec); err == nil {
+			t.Errorf("ParseWeekend(%q): expected an error, got nil", spec)
+		}
+	}
+}
+
+func TestHolidayDates(t *testing.T) {
+	t.Parallel()
+	holidays, err := NewHolidayDates("2026-12-25", "Jan 1, 2027")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !holidays.IsHoliday(time.Date(2026, 12, 25, 17, 30, 0, 0, time.UTC)) {
+		t.Error("expected Dec 25 to be a holiday at any time of day")
+	}
+	if !holidays.IsHoliday(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected Jan 1 to be a holiday")
+	}
+	if holidays.IsHoliday(time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected Dec 24 not to be a holiday")
+	}
+	if _, err := NewHolidayDates("2026-02-30"); err == nil {
+		t.Error("expected an error for an invalid holiday date, got nil")
+	}
+}
+
+func testBusinessDays(t *testing.T, options []OptionsDur, correctAdd, correctSub []string) {
+	t.Helper()
+	dur := NewDur(append(options, DurWithOutputFormat("%F %a"))...)
+	future, err := dur.Add()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(future, correctAdd) {
+		t.Errorf("[dur: %v] [computed: %v] != [correct: %v]", dur, future, correctAdd)
+	}
+	if correctSub == nil {
+		return
+	}
+	past, err := dur.Sub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(past, correctSub) {
+		t.Errorf("[dur: %v] [computed: %v] != [correct: %v]", dur, past, correctSub)
+	}
+}
+
+func TestDurBusinessDays(t *testing.T) {
+	t.Parallel()
+	// 2026-10-16 is a Friday
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-16"), DurWithDur("1 business day")},
+		[]string{"2026-10-19 Mon"}, []string{"2026-10-15 Thu"})
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-16"), DurWithDur("10B")},
+		[]string{"2026-10-30 Fri"}, []string{"2026-10-02 Fri"})
+	// starting on a weekend, the first business day counts as the first step
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-17"), DurWithDur("1 Business Days")},
+		[]string{"2026-10-19 Mon"}, []string{"2026-10-16 Fri"})
+	// mixed with other units, applied in order
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-16"), DurWithDur("1W2B")},
+		[]string{"2026-10-27 Tue"}, []string{"2026-10-07 Wed"})
+}
+
+func TestDurBusinessDaysWeekendAndHolidays(t *testing.T) {
+	t.Parallel()
+	// a Friday-Saturday weekend
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-15"), DurWithDur("1B"), DurWithWeekend(time.Friday, time.Saturday)},
+		[]string{"2026-10-18 Sun"}, []string{"2026-10-14 Wed"})
+	holidays, err := NewHolidayDates("2026-12-25", "2027-01-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-12-22"), DurWithDur("5B"), DurWithHolidays(holidays)},
+		[]string{"2026-12-30 Wed"}, nil)
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2027-01-04"), DurWithDur("1B"), DurWithHolidays(holidays)},
+		[]string{"2027-01-05 Tue"}, []string{"2026-12-31 Thu"})
+}
+
+func TestDurBusinessDaysRepeatUntil(t *testing.T) {
+	t.Parallel()
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-15"), DurWithDur("1B"), DurWithRepeat(3)},
+		[]string{"2026-10-16 Fri", "2026-10-19 Mon", "2026-10-20 Tue"}, []string{"2026-10-14 Wed", "2026-10-13 Tue", "2026-10-12 Mon"})
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-10-15"), DurWithDur("2B"), DurWithUntil("2026-10-22")},
+		[]string{"2026-10-19 Mon", "2026-10-21 Wed"}, nil)
+}
+
+func TestDurBusinessDaysInvalid(t *testing.T) {
+	t.Parallel()
+	dur := NewDur(DurWithFrom("2026-10-16"), DurWithDur("1.5 business days"))
+	if _, err := dur.Add(); err == nil || !strings.Contains(err.Error(), "whole") {
+		t.Errorf("expected a whole-number error for fractional business days, got: %v", err)
+	}
+	conv := NewConv(ConvWithSource("5B"), ConvWithTarget("days"))
+	if _, err := conv.ConvertDuration(); err == nil {
+		t.Error("expected conv to reject business days, got nil")
+	}
+}
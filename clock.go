@@ -0,0 +1,66 @@
+// clock.go supplies the reference instant that "now", relative dates, date
+// math, and bare times of day are resolved against: the system time, or a
+// Clock given to a Parser or to a type that parses
+
+package DateTimeMate
+
+import (
+	"strings"
+	"time"
+)
+
+// Clock supplies the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function such as time.Now to a Clock
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock reads the system time
+var SystemClock Clock = ClockFunc(time.Now)
+
+// FixedClock returns a Clock that always reports t
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+// nowParser reads a reference instant for ParseNow: relative input is
+// rejected, since it would need a reference instant itself
+var nowParser = &Parser{AllowUnix: true, Strict: true, Clock: SystemClock}
+
+// Now returns the reference instant used by the package-level functions and
+// by types without a Clock, the system time
+func Now() time.Time {
+	return time.Now()
+}
+
+// ParseNow parses a reference instant to pin with FixedClock: any absolute
+// date/time or unix timestamp, but nothing relative to the current time
+func ParseNow(value string) (time.Time, error) {
+	return nowParser.parse(strings.TrimSpace(value))
+}
+
+// now returns the Parser's reference instant: its Clock, or else Now
+func (p *Parser) now() time.Time {
+	if p.Clock != nil {
+		return p.Clock.Now()
+	}
+	return Now()
+}
+
+// withClock returns p, or a copy of it reading the reference instant from c
+// when c is set, so Diff, Dur, and TimeZoneConverter can take a Clock with
+// or without a Parser
+func (p *Parser) withClock(c Clock) *Parser {
+	if c == nil {
+		return p
+	}
+	clocked := *p.orDefault()
+	clocked.Clock = c
+	return &clocked
+}
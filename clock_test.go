@@ -0,0 +1,100 @@
+package DateTimeMate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseNow(t *testing.T) {
+	t.Parallel()
+	now, err := ParseNow(" 2026-03-02 09:00:00 ")
+	if err != nil {
+		t.Fatalf("ParseNow: %v", err)
+	}
+	if want := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local); !now.Equal(want) {
+		t.Errorf("ParseNow = %v, want %v", now, want)
+	}
+	if now, err := ParseNow("1704085262"); err != nil || now.Unix() != 1704085262 {
+		t.Errorf("ParseNow with a unix timestamp = %v, %v", now, err)
+	}
+	for _, value := range []string{"tomorrow", "14:30", "now-1d", "garbage"} {
+		if _, err := ParseNow(value); err == nil {
+			t.Errorf("ParseNow(%q): want error", value)
+		}
+	}
+}
+
+func TestNow(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	// the environment does not pin the library's reference instant
+	t.Setenv("DTMATE_NOW", "2001-02-03 04:05:06")
+	if since := time.Since(Now()); since < 0 || since > time.Minute {
+		t.Errorf("Now is %v from the system time", since)
+	}
+}
+
+func TestClockOptions(t *testing.T) {
+	t.Parallel()
+	ref := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := FixedClock(ref)
+
+	got, _, err := NewParser(ParserWithClock(clock), ParserWithLocation(time.UTC)).Parse("3 days ago")
+	if err != nil || !got.Equal(ref.AddDate(0, 0, -3)) {
+		t.Errorf("Parse(3 days ago) = %v, %v", got, err)
+	}
+
+	diff := NewDiff(DiffWithStart("yesterday"), DiffWithEnd("now"), DiffWithClock(clock))
+	_, d, err := diff.CalculateDiff()
+	if err != nil || d != 24*time.Hour {
+		t.Errorf("CalculateDiff = %v, %v, want 24h", d, err)
+	}
+
+	dur := NewDur(DurWithFrom("now"), DurWithDur("1D"), DurWithOutputFormat("%Y-%m-%d"), DurWithClock(clock))
+	if out, err := dur.Add(); err != nil || len(out) != 1 || out[0] != ref.In(time.Local).AddDate(0, 0, 1).Format("2006-01-02") {
+		t.Errorf("Dur.Add = %q, %v", out, err)
+	}
+
+	local := ref.In(time.Local)
+	for source, want := range map[string]string{
+		"now":      local.Format("2006-01-02 15:04:05"),
+		"tomorrow": local.AddDate(0, 0, 1).Format("2006-01-02 15:04:05"),
+		"14:30":    local.Format("2006-01-02") + " 14:30:00",
+		"now-1d/d": local.AddDate(0, 0, -1).Format("2006-01-02") + " 00:00:00",
+	} {
+		got, err := Reformat(source, "%Y-%m-%d %H:%M:%S", FormatWithClock(clock))
+		if err != nil || got != want {
+			t.Errorf("Reformat(%q) = %q, %v, want %q", source, got, err, want)
+		}
+	}
+	if d, err := DetectFormat("today", FormatWithClock(clock)); err != nil || !d.Time.Equal(ref.Truncate(time.Second)) {
+		t.Errorf("DetectFormat(today) = %v, %v", d.Time, err)
+	}
+
+	runs, err := NewCron(CronWithExpression("0 12 * * *"), CronWithCount(1), CronWithLocation(time.UTC), CronWithClock(clock)).Times()
+	if err != nil || len(runs) != 1 || !runs[0].Equal(ref.Add(3*time.Hour)) {
+		t.Errorf("Cron.Times = %v, %v", runs, err)
+	}
+	occurrences, err := NewRecurrence(RecurrenceWithRule("FREQ=DAILY;COUNT=1"), RecurrenceWithLocation(time.UTC), RecurrenceWithClock(clock)).Times()
+	if err != nil || len(occurrences) != 1 || !occurrences[0].Equal(ref) {
+		t.Errorf("Recurrence.Times = %v, %v", occurrences, err)
+	}
+	weeks, err := NewWeek(WeekWithSource("now"), WeekWithRules(ISOWeekRule), WeekWithClock(clock)).Weeks()
+	if err != nil || len(weeks) != 1 || weeks[0].Number != 10 {
+		t.Errorf("Week.Weeks = %v, %v", weeks, err)
+	}
+	fiscal, err := NewFiscal(FiscalWithSource("now"), FiscalWithOutputFormat("%Y-%m-%d"), FiscalWithClock(clock)).Expand()
+	if err != nil || len(fiscal) == 0 || !strings.Contains(strings.Join(fiscal, " "), "2026") {
+		t.Errorf("Fiscal.Expand = %v, %v", fiscal, err)
+	}
+	contains, err := NewRange(RangeWithOperation("contains"), RangeWithItems("2026-03-01,2026-03-03", "now"), RangeWithClock(clock)).Expand()
+	if err != nil || len(contains) != 1 || contains[0] != "true" {
+		t.Errorf("Range contains now = %v, %v", contains, err)
+	}
+
+	tzc := NewTimeZoneConverter(TimeZoneConverterWithClock(clock))
+	converted, err := tzc.ConvertTimeZone("now", "UTC")
+	if err != nil || !converted.Equal(ref) {
+		t.Errorf("ConvertTimeZone(now) = %v, %v, want %v", converted, err, ref)
+	}
+}
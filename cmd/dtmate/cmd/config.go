@@ -0,0 +1,388 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/jftuga/DateTimeMate/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// configEnvVar names the environment variable holding the path of the
+// configuration file, overridden by --config
+const configEnvVar = "DTMATE_CONFIG"
+
+// zoneGroupPrefix begins the keys of the [zones] section, each a named list
+// of time zones for tz --group
+const zoneGroupPrefix = "zones."
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show the settings read from the configuration file, the environment, and the built-in defaults",
+	Long: `The configuration file holds defaults for every command. Its path is
+--config, or else $DTMATE_CONFIG, or else $XDG_CONFIG_HOME/dtmate/config
+(~/.config/dtmate/config). A flag overrides the environment, which overrides
+the file, which overrides the built-in default.
+
+  # ~/.config/dtmate/config
+  date-order = DMY
+  zone-aliases = IST=Asia/Kolkata|CST=America/Chicago
+  week-start = sun
+  input-zone = America/Chicago
+  output-zone = Asia/Tokyo
+  brief = true
+
+  [format]
+  dur = %Y-%m-%d %H:%M
+  fmt = "%a %d %b %Y %T"
+
+  [zones]
+  team = Europe/London,Asia/Kolkata,America/Denver`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help() //nolint:errcheck
+		fmt.Println("\nSettings:")
+		for _, s := range allConfigSettings() {
+			fmt.Printf("  %-15s %s\n", s.key, s.description)
+		}
+		fmt.Printf("  %-15s %s\n", zoneGroupPrefix+"NAME", "comma-separated time zones for tz --group NAME")
+	},
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the effective value of each setting and where it came from",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		for _, line := range configLines() {
+			fmt.Println(line)
+		}
+	},
+}
+
+var optRootConfig string
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configShowCmd)
+}
+
+// configSetting is a key of the configuration file: the environment
+// variable that overrides it, or the flag it supplies the default
+// of on every command having that flag, unless the conflicting flag named
+// by unless is given, or only on the commands listed; a zone setting's
+// value must resolve as a time zone
+type configSetting struct {
+	key         string
+	envVar      string
+	builtin     string
+	flag        string
+	unless      string
+	commands    []string
+	description string
+	zone        bool
+	validate    func(string) error
+}
+
+var configSettings = []configSetting{
+	{key: "date-order", envVar: DateTimeMate.DateOrderEnvVar, builtin: DateTimeMate.DateOrderMDY,
+		description: "order of ambiguous slash dates: MDY or DMY",
+		validate: func(value string) error {
+			_, _, err := DateTimeMate.NewParser(DateTimeMate.ParserWithDateOrder(value)).Parse("2026-01-02")
+			return err
+		}},
+	{key: "zone-aliases", envVar: DateTimeMate.ZoneAliasesEnvVar,
+		description: "zone abbreviation overrides, such as IST=Asia/Kolkata|CST=America/Chicago",
+		validate: func(value string) error {
+			_, err := DateTimeMate.ParseZoneAliases(value)
+			return err
+		}},
+	{key: "week-start", flag: "first-day", builtin: "mon",
+		description: "first day of week's custom rule, added to iso and us, and of fiscal weeks",
+		validate: func(value string) error {
+			_, err := DateTimeMate.NewWeekRule(value, 4)
+			return err
+		}},
+	{key: "input-zone", flag: "in", zone: true,
+		description: "time zone of date/times without one on diff, dur, and fmt, such as America/Chicago"},
+	{key: "output-zone", flag: "tz", commands: []string{"diff", "dur", "fmt"}, zone: true,
+		description: "time zone results are converted to on diff, dur, and fmt, such as Asia/Tokyo"},
+	{key: "brief", flag: "brief", unless: "iso", builtin: "false",
+		description: "brief durations, such as 1Y3W4D5h6m7s, on diff, conv, durmath, and range",
+		validate: func(value string) error {
+			_, err := strconv.ParseBool(value)
+			return err
+		}},
+}
+
+// formatSettings returns a format.COMMAND setting for fmt's format argument
+// and for every command with a --format flag
+func formatSettings() []configSetting {
+	settings := []configSetting{{key: "format.fmt", description: "format specifiers when fmt is given none"}}
+	for _, c := range rootCmd.Commands() {
+		if f := c.Flags().Lookup("format"); f != nil && f.Value.Type() == "string" {
+			settings = append(settings, configSetting{key: "format." + c.Name(), flag: "format", unless: "layout",
+				builtin: f.DefValue, description: "--format of " + c.Name()})
+		}
+	}
+	slices.SortFunc(settings, func(a, b configSetting) int { return strings.Compare(a.key, b.key) })
+	return settings
+}
+
+// allConfigSettings returns every key the configuration file accepts
+func allConfigSettings() []configSetting {
+	return append(slices.Clone(configSettings), formatSettings()...)
+}
+
+// lookupSetting returns the setting of configSettings named key
+func lookupSetting(key string) configSetting {
+	return configSettings[slices.IndexFunc(configSettings, func(s configSetting) bool { return s.key == key })]
+}
+
+// loadedConfig is the configuration file found at path, if any
+type loadedConfig struct {
+	path string
+	file *config.File
+}
+
+var cliConfig *loadedConfig
+
+// currentConfig loads and validates the configuration file once; a missing
+// file at the default path is no configuration, while a missing file named
+// by --config or DTMATE_CONFIG, an unknown key, or an invalid value is an error
+func currentConfig() *loadedConfig {
+	if cliConfig != nil {
+		return cliConfig
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	cliConfig = cfg
+	return cfg
+}
+
+func loadConfig() (*loadedConfig, error) {
+	path, explicit := optRootConfig, true
+	if path == "" {
+		path = os.Getenv(configEnvVar)
+	}
+	if path == "" {
+		explicit = false
+		var err error
+		if path, err = config.DefaultPath(); err != nil {
+			return &loadedConfig{}, nil
+		}
+	}
+	file, err := config.Load(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return &loadedConfig{path: path}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	settings := allConfigSettings()
+	for key, entry := range file.Entries {
+		if strings.HasPrefix(key, zoneGroupPrefix) {
+			if err := validateZoneGroup(entry.Value, file); err != nil {
+				return nil, fmt.Errorf("config: %s:%d: %s: %w", path, entry.Line, key, err)
+			}
+			continue
+		}
+		i := slices.IndexFunc(settings, func(s configSetting) bool { return s.key == key })
+		if i < 0 {
+			return nil, fmt.Errorf("config: %s:%d: unknown setting %q", path, entry.Line, key)
+		}
+		if validate := settings[i].validate; validate != nil {
+			if err := validate(entry.Value); err != nil {
+				return nil, fmt.Errorf("config: %s:%d: %s: %w", path, entry.Line, key, err)
+			}
+		}
+		if settings[i].zone {
+			if err := validateConfigZone(entry.Value, file); err != nil {
+				return nil, fmt.Errorf("config: %s:%d: %s: %w", path, entry.Line, key, err)
+			}
+		}
+	}
+	return &loadedConfig{path: path, file: file}, nil
+}
+
+// validateZoneGroup checks that a zone group lists at least one zone and
+// that each resolves
+func validateZoneGroup(list string, file *config.File) error {
+	zones := splitZones(list)
+	if len(zones) == 0 {
+		return errors.New("lists no time zones")
+	}
+	for _, zone := range zones {
+		if err := validateConfigZone(zone, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateConfigZone resolves a zone setting with the aliases that will be
+// in effect: the environment's, or else the file's zone-aliases; an invalid
+// alias list is reported on its own
+func validateConfigZone(zone string, file *config.File) error {
+	spec := os.Getenv(DateTimeMate.ZoneAliasesEnvVar)
+	if spec == "" {
+		spec = file.Entries["zone-aliases"].Value
+	}
+	aliases, _ := DateTimeMate.ParseZoneAliases(spec)
+	_, err := DateTimeMate.NewTimeZoneConverter(
+		DateTimeMate.TimeZoneConverterWithZoneAbbrevs(DateTimeMate.LoadZoneDefinitions()),
+		DateTimeMate.TimeZoneConverterWithAliases(aliases)).ResolveLocation(zone)
+	return err
+}
+
+// configPath returns the path of the configuration file, for messages
+func configPath() string {
+	if path := currentConfig().path; path != "" {
+		return path
+	}
+	return "the configuration file"
+}
+
+// configEntry returns the configuration file's value for key
+func configEntry(key string) (config.Entry, bool) {
+	cfg := currentConfig()
+	if cfg.file == nil {
+		return config.Entry{}, false
+	}
+	entry, ok := cfg.file.Entries[key]
+	return entry, ok
+}
+
+// settingValue returns the effective value of a setting and where it came
+// from: the environment, the configuration file, or the built-in default
+func settingValue(s configSetting) (value, source string) {
+	if s.envVar != "" {
+		if value := os.Getenv(s.envVar); value != "" {
+			return value, "env " + s.envVar
+		}
+	}
+	if entry, ok := configEntry(s.key); ok {
+		return entry.Value, fmt.Sprintf("config %s:%d", currentConfig().path, entry.Line)
+	}
+	return s.builtin, "default"
+}
+
+// fromConfig reports whether the effective value of s comes from the
+// configuration file
+func fromConfig(s configSetting) bool {
+	_, source := settingValue(s)
+	return strings.HasPrefix(source, "config ")
+}
+
+// newParser returns the Parser every command reads date/times with when the
+// configuration file sets date-order, which the library's package-level
+// parsing, reading only DateTimeMate.DateOrderEnvVar, cannot see; otherwise
+// nil, so the library's own parser and its ambiguity warnings apply
+func newParser() *DateTimeMate.Parser {
+	s := lookupSetting("date-order")
+	if !fromConfig(s) {
+		return nil
+	}
+	order, _ := settingValue(s)
+	return DateTimeMate.NewParser(
+		DateTimeMate.ParserWithDateOrder(order),
+		DateTimeMate.ParserWithLocales(strings.Split(os.Getenv(DateTimeMate.ParseLocalesEnvVar), ",")...))
+}
+
+// zoneAliases returns the effective zone-aliases: the environment's, or
+// else the configuration file's
+func zoneAliases() (map[string]string, error) {
+	spec, _ := settingValue(lookupSetting("zone-aliases"))
+	return DateTimeMate.ParseZoneAliases(spec)
+}
+
+// inputZone returns an --in zone, or the IANA zone it names in the
+// configuration file's zone-aliases, which the library resolves --in
+// without; the environment's aliases the library reads itself
+func inputZone(zone string) string {
+	if !fromConfig(lookupSetting("zone-aliases")) {
+		return zone
+	}
+	aliases, _ := zoneAliases()
+	if target, ok := aliases[strings.ToUpper(strings.TrimSpace(zone))]; ok {
+		return target
+	}
+	return zone
+}
+
+// configFlags holds the flags of the running command whose values came from
+// the configuration file
+var configFlags = map[string]bool{}
+
+// applyConfig hands the configuration file's flag settings to the running
+// command: each becomes the value of its flag when the flag is not given;
+// the flag is not marked as changed, so its mutually exclusive flags are
+// unaffected. Settings overridden by an environment variable are read with
+// settingValue where they are used, by newParser and zoneAliases.
+func applyConfig(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	for _, s := range allConfigSettings() {
+		if s.flag == "" || (s.key != "format."+cmd.Name() && strings.HasPrefix(s.key, "format.")) {
+			continue
+		}
+		if s.commands != nil && !slices.Contains(s.commands, cmd.Name()) {
+			continue
+		}
+		f := flags.Lookup(s.flag)
+		entry, ok := configEntry(s.key)
+		if f == nil || !ok || f.Changed || (s.unless != "" && flags.Changed(s.unless)) {
+			continue
+		}
+		if err := f.Value.Set(entry.Value); err != nil {
+			fmt.Fprintf(os.Stderr, "config: %s:%d: %s: %v\n", currentConfig().path, entry.Line, s.key, err)
+			os.Exit(1)
+		}
+		configFlags[s.flag] = true
+	}
+}
+
+// configLines returns the lines of dtmate config show: the configuration
+// file, then each setting's value and source
+func configLines() []string {
+	cfg := currentConfig()
+	file := cfg.path
+	switch {
+	case file == "":
+		file = "none: neither $XDG_CONFIG_HOME nor $HOME is set"
+	case cfg.file == nil:
+		file += " (not found)"
+	}
+	lines := []string{"config file: " + file}
+	settings := allConfigSettings()
+	width := 0
+	for _, s := range settings {
+		width = max(width, len(s.key))
+	}
+	for _, s := range settings {
+		value, source := settingValue(s)
+		if value == "" {
+			value = "none"
+		} else {
+			value = strconv.Quote(value)
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %-24s  %s", width, s.key, value, source))
+	}
+	if cfg.file != nil {
+		for _, key := range slices.Sorted(maps.Keys(cfg.file.Entries)) {
+			if entry := cfg.file.Entries[key]; strings.HasPrefix(key, zoneGroupPrefix) {
+				lines = append(lines, fmt.Sprintf("%-*s  %-24s  config %s:%d", width, key, strconv.Quote(entry.Value), cfg.path, entry.Line))
+			}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jftuga/DateTimeMate"
+)
+
+// useConfig points the configuration at a file holding text, restoring the
+// previous configuration when the test ends
+func useConfig(t *testing.T, text string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(text), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	previous, previousConfig := optRootConfig, cliConfig
+	optRootConfig, cliConfig = path, nil
+	t.Cleanup(func() { optRootConfig, cliConfig = previous, previousConfig })
+	return path
+}
+
+func TestSettingValuePrecedence(t *testing.T) {
+	path := useConfig(t, "date-order = DMY\nbrief = true\n\n[format]\ndur = %F\n")
+	t.Setenv(DateTimeMate.DateOrderEnvVar, "")
+
+	settings := map[string]configSetting{}
+	for _, s := range allConfigSettings() {
+		settings[s.key] = s
+	}
+	tests := []struct {
+		key, value, source string
+	}{
+		{"date-order", "DMY", "config " + path + ":1"},
+		{"brief", "true", "config " + path + ":2"},
+		{"format.dur", "%F", "config " + path + ":5"},
+		{"week-start", "mon", "default"},
+		{"format.holidays", "%Y-%m-%d %a", "default"},
+		{"format.week", DateTimeMate.DefaultWeekOutputFormat, "default"},
+		{"format.fiscal", DateTimeMate.DefaultFiscalOutputFormat, "default"},
+	}
+	for _, tt := range tests {
+		value, source := settingValue(settings[tt.key])
+		if value != tt.value || source != tt.source {
+			t.Errorf("settingValue(%s) = %q, %q, want %q, %q", tt.key, value, source, tt.value, tt.source)
+		}
+	}
+
+	t.Setenv(DateTimeMate.DateOrderEnvVar, "MDY")
+	if value, source := settingValue(settings["date-order"]); value != "MDY" || source != "env DTMATE_DATE_ORDER" {
+		t.Errorf("settingValue(date-order) with the env var = %q, %q", value, source)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	for text, want := range map[string]string{
+		"colour = blue\n":                 `:1: unknown setting "colour"`,
+		"brief = maybe\n":                 ":1: brief:",
+		"date-order = YMD\n":              ":1: date-order:",
+		"\nweek-start = someday\n":        ":2: week-start:",
+		"[format]\nnope = %F\n":           `:2: unknown setting "format.nope"`,
+		"input-zone = Nowhere/Zone\n":     ":1: input-zone:",
+		"output-zone = Mars/Base\n":       ":1: output-zone:",
+		"[zones]\nteam = UTC,Mars/Base\n": ":2: zones.team:",
+		"[zones]\nteam = ,\n":             ":2: zones.team: lists no time zones",
+	} {
+		useConfig(t, text)
+		if _, err := loadConfig(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("loadConfig(%q): err = %v, want %q", text, err, want)
+		}
+	}
+
+	// a zone may be an alias defined in the file itself
+	t.Setenv(DateTimeMate.ZoneAliasesEnvVar, "")
+	useConfig(t, "input-zone = hq\nzone-aliases = HQ=Asia/Kolkata\n")
+	if _, err := loadConfig(); err != nil {
+		t.Errorf("loadConfig of an aliased input-zone: %v", err)
+	}
+
+	optRootConfig = filepath.Join(t.TempDir(), "missing")
+	if _, err := loadConfig(); err == nil {
+		t.Error("loadConfig of a missing --config file: want error")
+	}
+}
+
+func TestConfigLines(t *testing.T) {
+	path := useConfig(t, "week-start = sun\n")
+	lines := configLines()
+	if lines[0] != "config file: "+path {
+		t.Errorf("configLines()[0] = %q", lines[0])
+	}
+	found := false
+	for _, line := range lines[1:] {
+		if strings.HasPrefix(line, "week-start ") {
+			found = strings.Contains(line, `"sun"`) && strings.HasSuffix(line, "config "+path+":1")
+		}
+	}
+	if !found {
+		t.Errorf("configLines() = %q, want week-start from the file", lines)
+	}
+}
+
+func TestWeekStartKeepsDefaultRules(t *testing.T) {
+	defer func() { configFlags, optWeekFirstDay = map[string]bool{}, "mon" }()
+	configFlags, optWeekFirstDay = map[string]bool{"first-day": true}, "sun"
+	rules, err := weekRules(weekCmd)
+	want := []DateTimeMate.WeekRule{DateTimeMate.ISOWeekRule, DateTimeMate.USWeekRule, {FirstDay: time.Sunday, MinDays: 4}}
+	if err != nil || !slices.Equal(rules, want) {
+		t.Errorf("weekRules() with week-start = sun = %v, %v; want %v", rules, err, want)
+	}
+}
+
+func TestConfigReachesParsers(t *testing.T) {
+	useConfig(t, "date-order = DMY\nzone-aliases = TEAMZ=Asia/Tokyo\n")
+	t.Setenv(DateTimeMate.DateOrderEnvVar, "")
+	t.Setenv(DateTimeMate.ZoneAliasesEnvVar, "")
+	applyConfig(fmtCmd)
+	if value := os.Getenv(DateTimeMate.DateOrderEnvVar); value != "" {
+		t.Errorf("applyConfig set %s to %q", DateTimeMate.DateOrderEnvVar, value)
+	}
+	got, err := DateTimeMate.Reformat("03/04/2024", "%Y-%m-%d", DateTimeMate.FormatWithParser(newParser()))
+	if err != nil || got != "2024-04-03" {
+		t.Errorf("Reformat(03/04/2024) with date-order = DMY = %q, %v; want 2024-04-03", got, err)
+	}
+	if got := inputZone("teamz"); got != "Asia/Tokyo" {
+		t.Errorf("inputZone(teamz) = %q, want Asia/Tokyo", got)
+	}
+	if loc, err := newTimeZoneConverter().ResolveLocation("TEAMZ"); err != nil || loc.String() != "Asia/Tokyo" {
+		t.Errorf("ResolveLocation(TEAMZ) = %v, %v; want Asia/Tokyo", loc, err)
+	}
+
+	t.Setenv(DateTimeMate.DateOrderEnvVar, "MDY")
+	if p := newParser(); p != nil {
+		t.Errorf("newParser() with %s set = %+v, want nil", DateTimeMate.DateOrderEnvVar, p)
+	}
+}
+
+func TestZoneGroup(t *testing.T) {
+	path := useConfig(t, "[zones]\nTeam = Europe/London, Asia/Kolkata\n")
+	if got := zoneGroup("TEAM"); !slices.Equal(got, []string{"Europe/London", "Asia/Kolkata"}) {
+		t.Errorf("zoneGroup(TEAM) = %q", got)
+	}
+	lines := configLines()
+	if want := `zones.team       "Europe/London, Asia/Kolkata"  config ` + path + ":2"; lines[len(lines)-1] != want {
+		t.Errorf("configLines() ends with %q, want %q", lines[len(lines)-1], want)
+	}
+}
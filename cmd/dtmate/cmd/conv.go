@@ -15,6 +15,7 @@ import (
 // DisableFlagParsing means cobra never writes them, and the real values come
 // from parseConvArgs
 var optConvBrief bool
+var optConvISO bool
 var optConvDecimals int
 
 var convCmd = &cobra.Command{
@@ -25,7 +26,7 @@ var convCmd = &cobra.Command{
 	Args:               cobra.ArbitraryArgs,
 	DisableFlagParsing: true, // this allows for negative durations; flags are parsed manually in RunE
 	RunE: func(cmd *cobra.Command, args []string) error {
-		positional, brief, noNewline, help, decimals, err := parseConvArgs(args)
+		positional, brief, iso, noNewline, help, decimals, err := parseConvArgs(args)
 		if err != nil {
 			return err
 		}
@@ -38,7 +39,7 @@ var convCmd = &cobra.Command{
 		if noNewline {
 			optRootNoNewline = true
 		}
-		outputConvDuration(positional[0], positional[1], brief, decimals)
+		outputConvDuration(positional[0], positional[1], brief, iso, decimals)
 		return nil
 	},
 }
@@ -46,43 +47,47 @@ var convCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(convCmd)
 	convCmd.Flags().BoolVarP(&optConvBrief, "brief", "b", false, "output in brief format, such as: 1Y3W4D5h6m7s")
+	convCmd.Flags().BoolVar(&optConvISO, "iso", false, "output as an ISO 8601 duration, such as: P1DT12H")
 	convCmd.Flags().IntVarP(&optConvDecimals, "decimals", "d", 0, "show the smallest unit with this many decimal places, rounded")
 }
 
 // parseConvArgs manually separates flags from positional args because convCmd
 // disables cobra flag parsing to support negative durations; an arg starting
-// with "-" followed by a digit is a negative duration, not a flag
-func parseConvArgs(args []string) (positional []string, brief, noNewline, help bool, decimals int, err error) {
+// with "-" followed by a digit, or by the "P" of an ISO 8601 duration, is a
+// negative duration, not a flag
+func parseConvArgs(args []string) (positional []string, brief, iso, noNewline, help bool, decimals int, err error) {
 	for i := 0; i < len(args); i++ {
 		arg := args[i]
 		switch {
 		case arg == "--":
 			positional = append(positional, args[i+1:]...)
-			return positional, brief, noNewline, help, decimals, nil
+			return positional, brief, iso, noNewline, help, decimals, nil
 		case arg == "--brief":
 			brief = true
+		case arg == "--iso":
+			iso = true
 		case arg == "--nonewline":
 			noNewline = true
 		case arg == "--help":
 			help = true
 		case arg == "--decimals":
 			if i+1 >= len(args) {
-				return nil, false, false, false, 0, fmt.Errorf("flag needs an argument: --decimals")
+				return nil, false, false, false, false, 0, fmt.Errorf("flag needs an argument: --decimals")
 			}
 			i++
 			decimals, err = strconv.Atoi(args[i])
 			if err != nil {
-				return nil, false, false, false, 0, fmt.Errorf("invalid argument %q for --decimals", args[i])
+				return nil, false, false, false, false, 0, fmt.Errorf("invalid argument %q for --decimals", args[i])
 			}
 		case strings.HasPrefix(arg, "--decimals="):
 			value := strings.TrimPrefix(arg, "--decimals=")
 			decimals, err = strconv.Atoi(value)
 			if err != nil {
-				return nil, false, false, false, 0, fmt.Errorf("invalid argument %q for --decimals", value)
+				return nil, false, false, false, false, 0, fmt.Errorf("invalid argument %q for --decimals", value)
 			}
 		case strings.HasPrefix(arg, "--"):
-			return nil, false, false, false, 0, fmt.Errorf("unknown flag: %s", arg)
-		case len(arg) > 1 && arg[0] == '-' && (arg[1] < '0' || arg[1] > '9'):
+			return nil, false, false, false, false, 0, fmt.Errorf("unknown flag: %s", arg)
+		case len(arg) > 1 && arg[0] == '-' && (arg[1] < '0' || arg[1] > '9') && arg[1] != 'P':
 			for j := 1; j < len(arg); j++ {
 				switch arg[j] {
 				case 'b':
@@ -96,29 +101,29 @@ func parseConvArgs(args []string) (positional []string, brief, noNewline, help b
 					value := arg[j+1:]
 					if value == "" {
 						if i+1 >= len(args) {
-							return nil, false, false, false, 0, fmt.Errorf("flag needs an argument: 'd' in %s", arg)
+							return nil, false, false, false, false, 0, fmt.Errorf("flag needs an argument: 'd' in %s", arg)
 						}
 						i++
 						value = args[i]
 					}
 					decimals, err = strconv.Atoi(value)
 					if err != nil {
-						return nil, false, false, false, 0, fmt.Errorf("invalid argument %q for -d", value)
+						return nil, false, false, false, false, 0, fmt.Errorf("invalid argument %q for -d", value)
 					}
 					j = len(arg)
 				default:
-					return nil, false, false, false, 0, fmt.Errorf("unknown shorthand flag: %q in %s", arg[j], arg)
+					return nil, false, false, false, false, 0, fmt.Errorf("unknown shorthand flag: %q in %s", arg[j], arg)
 				}
 			}
 		default:
 			positional = append(positional, arg)
 		}
 	}
-	return positional, brief, noNewline, help, decimals, nil
+	return positional, brief, iso, noNewline, help, decimals, nil
 }
 
-func outputConvDuration(source, target string, brief bool, decimals int) {
-	conv := DateTimeMate.NewConv(DateTimeMate.ConvWithSource(source), DateTimeMate.ConvWithTarget(target), DateTimeMate.ConvWithBrief(brief), DateTimeMate.ConvWithDecimals(decimals))
+func outputConvDuration(source, target string, brief, iso bool, decimals int) {
+	conv := DateTimeMate.NewConv(DateTimeMate.ConvWithSource(source), DateTimeMate.ConvWithTarget(target), DateTimeMate.ConvWithBrief(brief), DateTimeMate.ConvWithISO(iso), DateTimeMate.ConvWithDecimals(decimals))
 	result, err := conv.ConvertDuration()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -11,6 +11,7 @@ func TestParseConvArgs(t *testing.T) {
 		args       []string
 		positional []string
 		brief      bool
+		iso        bool
 		noNewline  bool
 		help       bool
 		decimals   int
@@ -28,6 +29,7 @@ func TestParseConvArgs(t *testing.T) {
 		{name: "help long", args: []string{"--help"}, help: true},
 		{name: "negative brief duration", args: []string{"-90m", "h"}, positional: []string{"-90m", "h"}},
 		{name: "negative verbose duration", args: []string{"-1 hour 30 minutes", "m"}, positional: []string{"-1 hour 30 minutes", "m"}},
+		{name: "negative ISO 8601 duration", args: []string{"-PT90M", "h"}, positional: []string{"-PT90M", "h"}},
 		{name: "negative duration with flag", args: []string{"-b", "-90m", "h"}, positional: []string{"-90m", "h"}, brief: true},
 		{name: "double dash terminator", args: []string{"-b", "--", "-90m", "h"}, positional: []string{"-90m", "h"}, brief: true},
 		{name: "decimals separate", args: []string{"-d", "2", "90m", "h"}, positional: []string{"90m", "h"}, decimals: 2},
@@ -42,6 +44,8 @@ func TestParseConvArgs(t *testing.T) {
 		{name: "decimals missing value long", args: []string{"90m", "h", "--decimals"}, wantErr: true},
 		{name: "decimals bad value", args: []string{"-d", "x", "90m", "h"}, wantErr: true},
 		{name: "decimals bad value long equals", args: []string{"--decimals=x", "90m", "h"}, wantErr: true},
+		{name: "iso", args: []string{"--iso", "36h", "Dh"}, positional: []string{"36h", "Dh"}, iso: true},
+		{name: "iso with negative ISO 8601 duration", args: []string{"-PT90M", "hm", "--iso"}, positional: []string{"-PT90M", "hm"}, iso: true},
 		{name: "unknown shorthand", args: []string{"-x", "90m", "h"}, wantErr: true},
 		{name: "unknown shorthand in cluster", args: []string{"-bx", "90m", "h"}, wantErr: true},
 		{name: "unknown long flag", args: []string{"--bogus", "90m", "h"}, wantErr: true},
@@ -49,7 +53,7 @@ func TestParseConvArgs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			positional, brief, noNewline, help, decimals, err := parseConvArgs(tt.args)
+			positional, brief, iso, noNewline, help, decimals, err := parseConvArgs(tt.args)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatal("expected an error, got nil")
@@ -65,6 +69,9 @@ func TestParseConvArgs(t *testing.T) {
 			if brief != tt.brief {
 				t.Errorf("brief: [computed: %v] != [correct: %v]", brief, tt.brief)
 			}
+			if iso != tt.iso {
+				t.Errorf("iso: [computed: %v] != [correct: %v]", iso, tt.iso)
+			}
 			if noNewline != tt.noNewline {
 				t.Errorf("noNewline: [computed: %v] != [correct: %v]", noNewline, tt.noNewline)
 			}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var cronCmd = &cobra.Command{
+	Use:   "cron [expression]",
+	Short: "List the next or previous run times of a cron expression",
+	Example: `  dtmate cron "*/15 9-17 * * MON-FRI" --from now --count 5 --tz Europe/Berlin
+  dtmate cron "0 17 * * 5L" --prev --count 3
+  dtmate cron "30 2 * * *" --from 2026-03-07 --tz America/New_York -f "%F %H:%M %Z"
+  dtmate cron "0 9 15W * *" --explain`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputCron(args[0])
+	},
+}
+
+var (
+	optCronFrom    string
+	optCronCount   int
+	optCronPrev    bool
+	optCronZone    string
+	optCronFormat  string
+	optCronExplain bool
+)
+
+func init() {
+	rootCmd.AddCommand(cronCmd)
+	cronCmd.Flags().StringVarP(&optCronFrom, "from", "F", "", "list runs after this date/time (default now)")
+	cronCmd.Flags().IntVarP(&optCronCount, "count", "c", DateTimeMate.DefaultCronCount, "number of runs to list")
+	cronCmd.Flags().BoolVarP(&optCronPrev, "prev", "p", false, "list the runs before --from instead of after it, most recent first")
+	cronCmd.Flags().StringVarP(&optCronZone, "tz", "z", "", "time zone whose wall clock the expression follows, such as Europe/Berlin (default local time)")
+	cronCmd.Flags().StringVarP(&optCronFormat, "format", "f", "", "output results with strftime formatting")
+	cronCmd.Flags().BoolVar(&optCronExplain, "explain", false, "describe the expression in plain English instead of listing runs")
+}
+
+func outputCron(expression string) {
+	options := []DateTimeMate.OptionsCron{
+		DateTimeMate.CronWithExpression(expression),
+		DateTimeMate.CronWithFrom(optCronFrom),
+		DateTimeMate.CronWithCount(optCronCount),
+		DateTimeMate.CronWithPrevious(optCronPrev),
+		DateTimeMate.CronWithOutputFormat(optCronFormat),
+		DateTimeMate.CronWithLocale(outputLocale()),
+		DateTimeMate.CronWithClock(refClock),
+		DateTimeMate.CronWithParser(newParser()),
+	}
+	if optCronZone != "" {
+		loc, err := newTimeZoneConverter().ResolveLocation(optCronZone)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		options = append(options, DateTimeMate.CronWithLocation(loc))
+	}
+	c := DateTimeMate.NewCron(options...)
+	if optCronExplain {
+		explanation, err := c.Explain()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(explanation)
+		if !optRootNoNewline {
+			fmt.Println()
+		}
+		return
+	}
+	allResults, err := c.Expand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(allResults, delim))
+	if !optRootNoNewline && len(allResults) > 0 {
+		fmt.Println()
+	}
+}
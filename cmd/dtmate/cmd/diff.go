@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -17,7 +18,9 @@ var diffCmd = &cobra.Command{
 	Use:   "diff [start] [end]",
 	Short: "Output the difference between two date/times",
 	Example: `  dtmate diff 12:00:00 15:30:45
-  dtmate diff 2024-06-07T08:00:00Z 2024-06-08T09:02:03Z --conv s -b`,
+  dtmate diff 2024-06-07T08:00:00Z 2024-06-08T09:02:03Z --conv s -b
+  dtmate diff 2020-02-29 2024-02-29 --calendar
+  dtmate diff "2026-10-16 15:00" "2026-10-20 10:00" --business-hours --holidays US`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if optDiffReadFromStdin {
 			if len(args) == 0 {
@@ -46,18 +49,35 @@ var diffCmd = &cobra.Command{
 }
 
 var optDiffBrief bool
+var optDiffISO bool
 var optDiffReadFromStdin bool
 var optDiffConv string
 var optDiffDecimals int
 var optDiffAbsolute bool
+var optDiffCalendar bool
+var optDiffBizHours string
+var optDiffBizZone string
+var optDiffHolidays []string
+var optDiffIn string
+var optDiffTz string
+var optDiffTzForce bool
 
 func init() {
 	rootCmd.AddCommand(diffCmd)
 	diffCmd.Flags().BoolVarP(&optDiffBrief, "brief", "b", false, "output in brief format, such as: 1Y3W4D5h6m7s")
+	diffCmd.Flags().BoolVar(&optDiffISO, "iso", false, "output as an ISO 8601 duration, such as: P1DT2H3M4S")
 	diffCmd.Flags().BoolVarP(&optDiffReadFromStdin, "stdin", "i", false, "read the start and end date/times from STDIN instead of arguments")
 	diffCmd.Flags().StringVarP(&optDiffConv, "conv", "c", "", "convert resulting duration to another group of units")
 	diffCmd.Flags().IntVarP(&optDiffDecimals, "decimals", "d", 0, "with -c: show the smallest unit with this many decimal places, rounded")
 	diffCmd.Flags().BoolVarP(&optDiffAbsolute, "absolute", "A", false, "always output an absolute (positive) duration")
+	diffCmd.Flags().BoolVarP(&optDiffCalendar, "calendar", "C", false, "count calendar years, months, and days, such as: 4 years 1 month 2 days")
+	addInputZoneFlag(diffCmd, &optDiffIn)
+	addOutputZoneFlags(diffCmd, &optDiffTz, &optDiffTzForce)
+	addBusinessHoursFlags(diffCmd, &optDiffBizHours, &optDiffBizZone)
+	diffCmd.Flags().StringSliceVar(&optDiffHolidays, "holidays", nil, "with --business-hours: comma-separated built-in sets (e.g. US), .json/.csv/.ics files, or dates")
+	diffCmd.MarkFlagsMutuallyExclusive("calendar", "conv")
+	diffCmd.MarkFlagsMutuallyExclusive("brief", "iso")
+	diffCmd.MarkFlagsMutuallyExclusive("calendar", "business-hours")
 }
 
 // getInput reads the start and end date/times from r: either one line
@@ -100,8 +120,8 @@ func getInput(r io.Reader) (string, string, error) {
 }
 
 // convert duration from one group of units to another
-func convDuration(source, target string, brief bool, decimals int) string {
-	conv := DateTimeMate.NewConv(DateTimeMate.ConvWithSource(source), DateTimeMate.ConvWithTarget(target), DateTimeMate.ConvWithBrief(brief), DateTimeMate.ConvWithDecimals(decimals))
+func convDuration(source, target string, brief, iso bool, decimals int) string {
+	conv := DateTimeMate.NewConv(DateTimeMate.ConvWithSource(source), DateTimeMate.ConvWithTarget(target), DateTimeMate.ConvWithBrief(brief), DateTimeMate.ConvWithISO(iso), DateTimeMate.ConvWithDecimals(decimals))
 	result, err := conv.ConvertDuration()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -116,7 +136,17 @@ func outputDiff(start, end string, brief bool) {
 		fmt.Fprintln(os.Stderr, "-d/--decimals requires -c/--conv")
 		os.Exit(1)
 	}
-	diff := DateTimeMate.NewDiff(DateTimeMate.DiffWithStart(start), DateTimeMate.DiffWithEnd(end), DateTimeMate.DiffWithBrief(brief), DateTimeMate.DiffWithAbsolute(optDiffAbsolute))
+	if len(optDiffHolidays) > 0 && optDiffBizHours == "" {
+		fmt.Fprintln(os.Stderr, "--holidays requires --business-hours")
+		os.Exit(1)
+	}
+	diff := DateTimeMate.NewDiff(DateTimeMate.DiffWithStart(start), DateTimeMate.DiffWithEnd(end), DateTimeMate.DiffWithBrief(brief), DateTimeMate.DiffWithISO(optDiffISO), DateTimeMate.DiffWithAbsolute(optDiffAbsolute),
+		DateTimeMate.DiffWithInputZone(inputZone(optDiffIn)), DateTimeMate.DiffWithBusinessSchedule(newBusinessSchedule(optDiffBizHours, optDiffBizZone, optDiffHolidays)),
+		DateTimeMate.DiffWithClock(refClock), DateTimeMate.DiffWithParser(newParser()))
+	if optDiffCalendar {
+		outputCalendarDiff(diff)
+		return
+	}
 	result, duration, err := diff.CalculateDiff()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -126,11 +156,66 @@ func outputDiff(start, end string, brief bool) {
 		// convert from the exact duration, not the human-readable string:
 		// the formatted string truncates sub-unit remainders and humandur
 		// uses 365-day years while conv uses 365.25
-		result = convDuration(fmt.Sprintf("%d nanoseconds", duration.Nanoseconds()), optDiffConv, optDiffBrief, optDiffDecimals)
+		result = convDuration(fmt.Sprintf("%d nanoseconds", duration.Nanoseconds()), optDiffConv, optDiffBrief, optDiffISO, optDiffDecimals)
+	}
+	printDiffLines(append([]string{result}, endpointLines(diff)...))
+}
+
+// endpointLines returns the start and end date/times converted to the --tz
+// zone, or nothing when --tz is not given
+func endpointLines(diff *DateTimeMate.Diff) []string {
+	tz := outputZoneConverter(optDiffTz, optDiffTzForce)
+	if tz == nil {
+		return nil
+	}
+	start, end, err := diff.Endpoints()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var lines []string
+	for _, endpoint := range []struct {
+		label string
+		t     time.Time
+	}{{"start:", start}, {"end:", end}} {
+		t, err := tz.ConvertTime(endpoint.t, optDiffTz)
+		if err != nil {
+			exitWithConversionError(err, "tz-force")
+		}
+		lines = append(lines, fmt.Sprintf("%-6s %s", endpoint.label, t.Format("2006-01-02 15:04:05 -0700 MST")))
 	}
+	return lines
+}
+
+// printDiffLines prints diff's output lines, joined by commas with -n
+func printDiffLines(lines []string) {
+	delim := "\n"
 	if optRootNoNewline {
-		fmt.Print(result)
-	} else {
-		fmt.Println(result)
+		delim = ","
+	}
+	fmt.Print(strings.Join(lines, delim))
+	if !optRootNoNewline {
+		fmt.Println()
+	}
+}
+
+// outputCalendarDiff prints the calendar difference; when a DST change
+// makes the wall-clock span differ from the real elapsed time, the elapsed
+// time, as the default diff output renders it, is reported on a second line
+func outputCalendarDiff(diff *DateTimeMate.Diff) {
+	result, cd, err := diff.CalculateCalendarDiff()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	lines := []string{result}
+	if cd.CrossesDST() {
+		elapsed, _, err := diff.CalculateDiff()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		lines = append(lines, "elapsed: "+elapsed)
 	}
+	printDiffLines(append(lines, endpointLines(diff)...))
 }
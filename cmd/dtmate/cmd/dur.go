@@ -14,7 +14,12 @@ var durCmd = &cobra.Command{
 	Use:   "dur [from] [duration]",
 	Short: "Output a date/time when given a starting date/time and duration",
 	Example: `  dtmate dur now 1D2h -a
-  dtmate dur today 7h10m -a -u tomorrow`,
+  dtmate dur today 7h10m -a -u tomorrow
+  dtmate dur 2024-01-31 1M -a -r 3 --end-of-month clamp
+  dtmate dur 2026-12-22 "5 business days" -a --holidays 2026-12-25,2027-01-01
+  dtmate dur 2026-11-25 2B -a --holidays US
+  dtmate dur "2026-10-16 15:00" 16h -a --business-hours --business-zone America/Chicago
+  dtmate dur 2026-10-18 "FREQ=MONTHLY;BYDAY=-1FR" -a -r 3`,
 	Args: cobra.MatchAll(cobra.ExactArgs(2)),
 	Run: func(cmd *cobra.Command, args []string) {
 		outputDur(args[0], args[1], optDurUntil, optDurFormat, optDurRepeat)
@@ -22,11 +27,20 @@ var durCmd = &cobra.Command{
 }
 
 var (
-	optDurAdd    bool
-	optDurSub    bool
-	optDurUntil  string
-	optDurFormat string
-	optDurRepeat int
+	optDurAdd      bool
+	optDurSub      bool
+	optDurUntil    string
+	optDurFormat   string
+	optDurLayout   string
+	optDurRepeat   int
+	optDurEOM      string
+	optDurWeekend  string
+	optDurHolidays []string
+	optDurBizHours string
+	optDurBizZone  string
+	optDurIn       string
+	optDurTz       string
+	optDurTzForce  bool
 )
 
 func init() {
@@ -35,16 +49,68 @@ func init() {
 	durCmd.Flags().BoolVarP(&optDurSub, "sub", "s", false, "subtract the duration from the starting date/time")
 	durCmd.Flags().StringVarP(&optDurUntil, "until", "u", "", "repeat duration until this date/time is exceeded")
 	durCmd.Flags().StringVarP(&optDurFormat, "format", "f", "", "output results with strftime formatting")
+	durCmd.Flags().StringVar(&optDurLayout, "layout", "", "output results with a Go reference layout or a named layout such as RFC3339")
 	durCmd.Flags().IntVarP(&optDurRepeat, "repeat", "r", 0, "repeat the -a or -s duration this number of times (mutually exclusive with -u)")
+	durCmd.Flags().StringVar(&optDurEOM, "end-of-month", "overflow", "month/year arithmetic past a month's last day: overflow (Jan 31 + 1M = Mar 2) or clamp (= Feb 29)")
+	durCmd.Flags().StringVar(&optDurWeekend, "weekend", "sat,sun", "weekdays skipped by business days (B), such as: fri,sat or fri-sat")
+	addInputZoneFlag(durCmd, &optDurIn)
+	addOutputZoneFlags(durCmd, &optDurTz, &optDurTzForce)
+	durCmd.Flags().StringSliceVar(&optDurHolidays, "holidays", nil, "holidays skipped by business days (B): comma-separated built-in sets (e.g. US), .json/.csv/.ics files, or dates")
+	addBusinessHoursFlags(durCmd, &optDurBizHours, &optDurBizZone)
 	durCmd.MarkFlagsOneRequired("add", "sub")
 	durCmd.MarkFlagsMutuallyExclusive("add", "sub")
 	durCmd.MarkFlagsMutuallyExclusive("repeat", "until")
+	durCmd.MarkFlagsMutuallyExclusive("business-hours", "weekend")
 	durCmd.SetFlagErrorFunc(negativeDurationHint("dur", "Use -s/--sub to subtract, e.g.:\n  dtmate dur now 1h -s"))
 }
 
+// addBusinessHoursFlags registers the --business-hours and --business-zone
+// flags; --business-hours alone selects DefaultBusinessHours, and a custom
+// schedule must be attached with "=", as in --business-hours="mon-fri 08:00-16:00",
+// so the flag never consumes a positional argument
+func addBusinessHoursFlags(cmd *cobra.Command, hours, zone *string) {
+	cmd.Flags().StringVar(hours, "business-hours", "", "count only working time on a weekly schedule (default \""+DateTimeMate.DefaultBusinessHours+"\" when given without a value)")
+	cmd.Flags().Lookup("business-hours").NoOptDefVal = DateTimeMate.DefaultBusinessHours
+	cmd.Flags().StringVar(zone, "business-zone", "", "time zone of the business hours, such as America/Chicago (default local time)")
+}
+
+// newBusinessSchedule builds the schedule for the --business-hours,
+// --business-zone, and --holidays flags; it returns nil when
+// --business-hours is not given
+func newBusinessSchedule(hours, zone string, holidays []string) *DateTimeMate.BusinessSchedule {
+	if hours == "" {
+		if zone != "" {
+			fmt.Fprintln(os.Stderr, "--business-zone requires --business-hours")
+			os.Exit(1)
+		}
+		return nil
+	}
+	week, err := DateTimeMate.ParseBusinessWeek(hours)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	options := []DateTimeMate.OptionsBusinessSchedule{DateTimeMate.BusinessScheduleWithWeek(week)}
+	if zone != "" {
+		loc, err := newTimeZoneConverter().ResolveLocation(zone)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		options = append(options, DateTimeMate.BusinessScheduleWithLocation(loc))
+	}
+	cal, err := DateTimeMate.LoadHolidayCalendar(holidays...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	options = append(options, DateTimeMate.BusinessScheduleWithHolidays(cal))
+	return DateTimeMate.NewBusinessSchedule(options...)
+}
+
 // negativeDurationHint returns a cobra flag-error function for the given verb
 // that rewrites the cryptic pflag error produced when a user passes a
-// negative-looking duration (e.g. "-1h") into a clear message containing the
+// negative-looking duration (e.g. "-1h" or "-PT1H") into a clear message containing the
 // given hint. Any other flag error is returned unchanged.
 func negativeDurationHint(verb, hint string) func(*cobra.Command, error) error {
 	return func(cmd *cobra.Command, err error) error {
@@ -52,7 +118,7 @@ func negativeDurationHint(verb, hint string) func(*cobra.Command, error) error {
 		msg := err.Error()
 		if i := strings.Index(msg, marker); i != -1 {
 			c := msg[i+len(marker)]
-			if c >= '0' && c <= '9' {
+			if (c >= '0' && c <= '9') || c == 'P' {
 				return fmt.Errorf("'%s' does not accept negative durations.\n%s\n", verb, hint)
 			}
 		}
@@ -61,23 +127,47 @@ func negativeDurationHint(verb, hint string) func(*cobra.Command, error) error {
 }
 
 func outputDur(from, duration, until, format string, repeat int) {
+	policy, err := DateTimeMate.ParseEndOfMonthPolicy(optDurEOM)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	weekend, err := DateTimeMate.ParseWeekend(optDurWeekend)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	holidays, err := DateTimeMate.LoadHolidayCalendar(optDurHolidays...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	dur := DateTimeMate.NewDur(
 		DateTimeMate.DurWithFrom(from),
 		DateTimeMate.DurWithDur(duration),
 		DateTimeMate.DurWithUntil(until),
 		DateTimeMate.DurWithRepeat(repeat),
-		DateTimeMate.DurWithOutputFormat(format))
+		DateTimeMate.DurWithOutputFormat(format),
+		DateTimeMate.DurWithLayout(optDurLayout),
+		DateTimeMate.DurWithLocale(outputLocale()),
+		DateTimeMate.DurWithEndOfMonth(policy),
+		DateTimeMate.DurWithWeekend(weekend...),
+		DateTimeMate.DurWithHolidays(holidays),
+		DateTimeMate.DurWithInputZone(inputZone(optDurIn)),
+		DateTimeMate.DurWithOutputZone(optDurTz),
+		DateTimeMate.DurWithTimeZoneConverter(outputZoneConverter(optDurTz, optDurTzForce)),
+		DateTimeMate.DurWithBusinessSchedule(newBusinessSchedule(optDurBizHours, optDurBizZone, optDurHolidays)),
+		DateTimeMate.DurWithClock(refClock),
+		DateTimeMate.DurWithParser(newParser()))
 
 	var allResults []string
-	var err error
 	if optDurAdd {
 		allResults, err = dur.Add()
 	} else {
 		allResults, err = dur.Sub()
 	}
 	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		exitWithConversionError(err, "tz-force")
 	}
 
 	delim := "\n"
@@ -21,6 +21,7 @@ func TestNegativeDurationHint(t *testing.T) {
 		{name: "brief negative duration", err: errors.New("unknown shorthand flag: '1' in -1h"), rewrite: true, contains: "-s/--sub"},
 		{name: "fractional negative duration", err: errors.New("unknown shorthand flag: '1' in -1.5h"), rewrite: true, contains: "-s/--sub"},
 		{name: "verbose negative duration", err: errors.New("unknown shorthand flag: '9' in -90m"), rewrite: true, contains: "-s/--sub"},
+		{name: "negative ISO 8601 duration", err: errors.New("unknown shorthand flag: 'P' in -PT1H"), rewrite: true, contains: "-s/--sub"},
 		{name: "unknown letter flag", err: errors.New("unknown shorthand flag: 'x' in -x"), rewrite: false},
 		{name: "unrelated error", err: errors.New("some other error"), rewrite: false},
 	}
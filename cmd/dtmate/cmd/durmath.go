@@ -1,8 +1,9 @@
 // durmath.go implements the 'durmath' sub-command, which adds or subtracts
 // two durations that may be expressed in different units. The operation is
 // selected with -a/--add or -s/--sub, and the signed result can optionally be
-// converted to target units (-c), rounded (-d), output in brief form (-b),
-// or rendered as an absolute (positive) duration (-A).
+// converted to target units (-c), rounded (-d), output in brief form (-b)
+// or as an ISO 8601 duration (--iso), or rendered as an absolute (positive)
+// duration (-A).
 
 package cmd
 
@@ -33,6 +34,7 @@ var (
 	optDurMathSub      bool
 	optDurMathConv     string
 	optDurMathBrief    bool
+	optDurMathISO      bool
 	optDurMathDecimals int
 	optDurMathAbsolute bool
 )
@@ -43,10 +45,12 @@ func init() {
 	durMathCmd.Flags().BoolVarP(&optDurMathSub, "sub", "s", false, "subtract the second duration from the first")
 	durMathCmd.Flags().StringVarP(&optDurMathConv, "conv", "c", "", "convert resulting duration to another group of units")
 	durMathCmd.Flags().BoolVarP(&optDurMathBrief, "brief", "b", false, "output in brief format, such as: 1Y3W4D5h6m7s")
+	durMathCmd.Flags().BoolVar(&optDurMathISO, "iso", false, "output as an ISO 8601 duration, such as: PT2H15M")
 	durMathCmd.Flags().IntVarP(&optDurMathDecimals, "decimals", "d", 0, "with -c: show the smallest unit with this many decimal places, rounded")
 	durMathCmd.Flags().BoolVarP(&optDurMathAbsolute, "absolute", "A", false, "always output an absolute (positive) duration")
 	durMathCmd.MarkFlagsOneRequired("add", "sub")
 	durMathCmd.MarkFlagsMutuallyExclusive("add", "sub")
+	durMathCmd.MarkFlagsMutuallyExclusive("brief", "iso")
 	durMathCmd.SetFlagErrorFunc(negativeDurationHint("durmath", "Use -a/--add or -s/--sub to control the operation, e.g.:\n  dtmate durmath 2h 30m -s"))
 }
 
@@ -63,6 +67,7 @@ func outputDurMath(first, second string) error {
 		DateTimeMate.DurMathWithSecond(second),
 		DateTimeMate.DurMathWithTarget(optDurMathConv),
 		DateTimeMate.DurMathWithBrief(optDurMathBrief),
+		DateTimeMate.DurMathWithISO(optDurMathISO),
 		DateTimeMate.DurMathWithDecimals(optDurMathDecimals),
 		DateTimeMate.DurMathWithAbsolute(optDurMathAbsolute))
 
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var fiscalCmd = &cobra.Command{
+	Use:   "fiscal [fiscal period or date]",
+	Short: "Output the fiscal year, quarter, period, and week of a date, or the first and last days of a fiscal period",
+	Long: `Output the fiscal year, quarter, period, and week holding a date, each with
+its first and last days, or the first and last days of one fiscal period
+given as FY2027, FY2027-Q1, FY2027-P02, or FY2027-W06.
+
+Fiscal years are labeled by the calendar year in which they end unless
+--label start is given. Periods are calendar months, or follow a 4-4-5,
+4-5-4, or 5-4-4 retail --pattern of 52 or 53 whole weeks.`,
+	Example: `  dtmate fiscal 2026-11-03 --start-month 10
+  dtmate fiscal FY2027-Q2 --start-month 10
+  dtmate fiscal today --start-month 2 --pattern 4-5-4 --first-day sun --label start`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputFiscal(args[0])
+	},
+}
+
+var (
+	optFiscalStartMonth int
+	optFiscalPattern    string
+	optFiscalFirstDay   string
+	optFiscalLabel      string
+	optFiscalFormat     string
+)
+
+func init() {
+	rootCmd.AddCommand(fiscalCmd)
+	fiscalCmd.Flags().IntVarP(&optFiscalStartMonth, "start-month", "s", 1, "month the fiscal year starts in, 1-12")
+	fiscalCmd.Flags().StringVarP(&optFiscalPattern, "pattern", "p", "calendar", "periods: calendar months, or weeks per period: 4-4-5, 4-5-4, 5-4-4")
+	fiscalCmd.Flags().StringVar(&optFiscalFirstDay, "first-day", "mon", "first day of fiscal weeks, and of a retail pattern's year")
+	fiscalCmd.Flags().StringVarP(&optFiscalLabel, "label", "l", "end", "label fiscal years by the calendar year they end or start in: end, start")
+	fiscalCmd.Flags().StringVarP(&optFiscalFormat, "format", "f", DateTimeMate.DefaultFiscalOutputFormat, "output the first and last days with strftime formatting")
+}
+
+// fiscalCalendar returns the fiscal calendar defined by the command's flags
+func fiscalCalendar() (DateTimeMate.FiscalCalendar, error) {
+	calendar, err := DateTimeMate.NewFiscalCalendar(optFiscalStartMonth, optFiscalPattern, optFiscalFirstDay)
+	if err != nil {
+		return calendar, err
+	}
+	switch strings.ToLower(optFiscalLabel) {
+	case "end":
+	case "start":
+		calendar.LabelByStartYear = true
+	default:
+		return calendar, fmt.Errorf("--label must be end or start, not %q", optFiscalLabel)
+	}
+	return calendar, nil
+}
+
+func outputFiscal(source string) {
+	calendar, err := fiscalCalendar()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fiscal := DateTimeMate.NewFiscal(
+		DateTimeMate.FiscalWithSource(source),
+		DateTimeMate.FiscalWithCalendar(calendar),
+		DateTimeMate.FiscalWithOutputFormat(optFiscalFormat),
+		DateTimeMate.FiscalWithLocale(outputLocale()),
+		DateTimeMate.FiscalWithClock(refClock),
+		DateTimeMate.FiscalWithParser(newParser()))
+	allResults, err := fiscal.Expand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(allResults, delim))
+	if !optRootNoNewline && len(allResults) > 0 {
+		fmt.Println()
+	}
+}
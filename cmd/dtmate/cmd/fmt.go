@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/jftuga/DateTimeMate"
 	"github.com/spf13/cobra"
@@ -12,12 +13,36 @@ import (
 var fmtCmd = &cobra.Command{
 	Use:   "fmt [date/time] [format specifiers]",
 	Short: "Reformat a date/time",
+	Long: `Reformat a date/time with strftime format specifiers, listed by --list, or
+with --layout and a Go reference layout such as "2006-01-02T15:04:05Z07:00"
+or one of the time package's named layouts, such as RFC3339 or Kitchen.
+
+--detect reports how a date/time was read instead: the parsing layer and
+layout that matched, the kind of input, where its zone came from, how an
+ambiguous slash date was ordered, and the equivalent strftime format and Go
+layout.`,
 	Example: `  dtmate fmt "2024-06-07 08:01:02" "%v %r"
-  dtmate fmt now %s`,
+  dtmate fmt now %s
+  dtmate fmt now --layout "2006-01-02T15:04:05.000Z07:00"
+  dtmate fmt now --layout RFC1123Z
+  dtmate fmt "22/07/2026 08:30" --detect
+  dtmate fmt "last monday of march" "%F %a" --explain`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if optFmtList {
 			return cobra.NoArgs(cmd, args)
 		}
+		if optFmtDetect {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+		if optFmtLayout != "" {
+			// a format given too is rejected with the library's error
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		}
+		if len(args) == 1 {
+			if _, ok := configEntry("format.fmt"); ok {
+				return nil
+			}
+		}
 		if len(args) != 2 {
 			return errors.New("requires two arguments: [date/time] [format specifiers]")
 		}
@@ -28,19 +53,44 @@ var fmtCmd = &cobra.Command{
 			listConversionsSpecifiers()
 			return
 		}
-		reformat(args[0], args[1])
+		if optFmtDetect {
+			detectFormat(args[0])
+			return
+		}
+		outputFormat := ""
+		if len(args) == 2 {
+			outputFormat = args[1]
+		} else if entry, ok := configEntry("format.fmt"); ok && optFmtLayout == "" {
+			outputFormat = entry.Value
+		}
+		reformat(args[0], outputFormat)
 	},
 }
 
 var optFmtList bool
+var optFmtExplain bool
+var optFmtLayout string
+var optFmtDetect bool
+var optFmtIn string
+var optFmtTz string
+var optFmtTzForce bool
 
 func init() {
 	rootCmd.AddCommand(fmtCmd)
 	fmtCmd.Flags().BoolVarP(&optFmtList, "list", "l", false, "list supported conversion specifiers")
+	fmtCmd.Flags().StringVar(&optFmtLayout, "layout", "", "output with a Go reference layout or a named layout such as RFC3339, instead of format specifiers")
+	fmtCmd.Flags().BoolVar(&optFmtDetect, "detect", false, "report which input shape, layer, layout, and zone read the date/time, with equivalent formats")
+	fmtCmd.MarkFlagsMutuallyExclusive("detect", "layout")
+	fmtCmd.MarkFlagsMutuallyExclusive("detect", "list")
+	addInputZoneFlag(fmtCmd, &optFmtIn)
+	addOutputZoneFlags(fmtCmd, &optFmtTz, &optFmtTzForce)
+	fmtCmd.MarkFlagsMutuallyExclusive("detect", "tz")
+	fmtCmd.Flags().BoolVar(&optFmtExplain, "explain", false, "write how a relative date such as \"next friday 9am\" was read to stderr")
 }
 
 // listConversionsSpecifiers the list was copied from:
 // https://github.com/lestrrat-go/strftime
+// and extended with DateTimeMate's own specifiers (see strftime.go)
 func listConversionsSpecifiers() {
 	list := [][]string{
 		{`%A`, `national representation of the full weekday name`},
@@ -53,6 +103,7 @@ func listConversionsSpecifiers() {
 		{`%d`, `day of the month as a decimal number (01-31)`},
 		{`%e`, `the day of the month as a decimal number (1-31); single digits are preceded by a blank`},
 		{`%F`, `equivalent to %Y-%m-%d`},
+		{`%f`, `microseconds as a 6-digit decimal number (000000-999999); same as %6N`},
 		{`%G`, `the ISO 8601 week-based year with century as a decimal number`},
 		{`%g`, `the ISO 8601 week-based year without century as a decimal number (00-99)`},
 		{`%H`, `the hour (24-hour clock) as a decimal number (00-23)`},
@@ -60,15 +111,21 @@ func listConversionsSpecifiers() {
 		{`%I`, `the hour (12-hour clock) as a decimal number (01-12)`},
 		{`%j`, `the day of the year as a decimal number (001-366)`},
 		{`%k`, `the hour (24-hour clock) as a decimal number (0-23); single digits are preceded by a blank`},
+		{`%L`, `milliseconds as a 3-digit decimal number (000-999); same as %3N`},
 		{`%l`, `the hour (12-hour clock) as a decimal number (1-12); single digits are preceded by a blank`},
 		{`%M`, `the minute as a decimal number (00-59)`},
 		{`%m`, `the month as a decimal number (01-12)`},
+		{`%N`, `nanoseconds as a 9-digit decimal number (000000000-999999999); same as %9N`},
+		{`%3N`, `fractional seconds to 3, 6, or 9 digits: %3N, %6N, %9N`},
 		{`%n`, `a newline`},
+		{`%o`, `the ordinal suffix of the day of the month (st, nd, rd, th), e.g. %-d%o for 3rd`},
 		{`%p`, `national representation of either 'ante meridiem' (a.m.)  or 'post meridiem' (p.m.)  as appropriate.`},
+		{`%Q`, `the quarter of the year as a decimal number (1-4)`},
 		{`%R`, `equivalent to %H:%M`},
 		{`%r`, `equivalent to %I:%M:%S %p`},
 		{`%S`, `the second as a decimal number (00-60)`},
 		{`%s`, `the number of seconds since the Epoch, 1970-01-01 00:00:00 +0000 (UTC)`},
+		{`%3s`, `the Unix time in milliseconds, microseconds, or nanoseconds: %3s, %6s, %9s`},
 		{`%T`, `equivalent to %H:%M:%S`},
 		{`%t`, `a tab`},
 		{`%U`, `the week number of the year (Sunday as the first day of the week) as a decimal number (00-53)`},
@@ -91,13 +148,27 @@ func listConversionsSpecifiers() {
 	for i := 0; i < len(list); i++ {
 		fmt.Printf("%s  %s\n", list[i][0], list[i][1])
 	}
+
+	fmt.Println()
+	fmt.Println("named layouts for --layout:")
+	for _, name := range DateTimeMate.LayoutNames() {
+		fmt.Printf("%-11s  %s\n", name, DateTimeMate.LayoutPresets[name])
+	}
 }
 
 func reformat(source, outputFormat string) {
-	result, err := DateTimeMate.Reformat(source, outputFormat)
+	result, err := DateTimeMate.Reformat(source, outputFormat,
+		DateTimeMate.FormatWithLocale(outputLocale()), DateTimeMate.FormatWithLayout(optFmtLayout),
+		DateTimeMate.FormatWithInputZone(inputZone(optFmtIn)), DateTimeMate.FormatWithOutputZone(optFmtTz),
+		DateTimeMate.FormatWithTimeZoneConverter(outputZoneConverter(optFmtTz, optFmtTzForce)),
+		DateTimeMate.FormatWithClock(refClock), DateTimeMate.FormatWithParser(newParser()))
 	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		exitWithConversionError(err, "tz-force")
+	}
+	if optFmtExplain {
+		if _, phrase, err := DateTimeMate.ParseRelativeDate(source, currentTime()); err == nil {
+			fmt.Fprintf(os.Stderr, "read %q as: %s\n", source, phrase)
+		}
 	}
 	if optRootNoNewline {
 		fmt.Print(result)
@@ -105,3 +176,49 @@ func reformat(source, outputFormat string) {
 		fmt.Println(result)
 	}
 }
+
+// detectionLines labels each field of a detection, with "none" for the
+// fields that do not apply
+func detectionLines(d DateTimeMate.Detection) []string {
+	layer := d.Layer
+	if d.AnchorLayer != "" {
+		layer += ", anchor read as: " + d.AnchorLayer
+	}
+	fields := [][2]string{
+		{"input", d.Source},
+		{"parsed", d.Time.Format("2006-01-02 15:04:05.999999999 -0700 MST")},
+		{"layer", layer},
+		{"layout", d.Layout},
+		{"kind", d.Kind},
+		{"zone", d.Zone},
+		{"date order", d.DateOrder},
+		{"strftime", d.Strftime},
+		{"go layout", d.GoLayout},
+	}
+	lines := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value := field[1]
+		if value == "" {
+			value = "none"
+		}
+		lines = append(lines, fmt.Sprintf("%-11s %s", field[0]+":", value))
+	}
+	return lines
+}
+
+func detectFormat(source string) {
+	d, err := DateTimeMate.DetectFormat(source, DateTimeMate.FormatWithInputZone(inputZone(optFmtIn)),
+		DateTimeMate.FormatWithClock(refClock), DateTimeMate.FormatWithParser(newParser()))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(detectionLines(d), delim))
+	if !optRootNoNewline {
+		fmt.Println()
+	}
+}
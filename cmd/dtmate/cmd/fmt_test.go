@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jftuga/DateTimeMate"
+)
+
+func TestDetectionLines(t *testing.T) {
+	d := DateTimeMate.Detection{
+		Source:   "20240101",
+		Time:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Layer:    DateTimeMate.LayerCompactInteger,
+		Layout:   "20060102",
+		Kind:     DateTimeMate.KindWallClock,
+		Zone:     "none in the input: local time (UTC, UTC+00:00)",
+		Strftime: "%Y%m%d",
+		GoLayout: "20060102",
+	}
+	want := []string{
+		"input:      20240101",
+		"parsed:     2024-01-01 00:00:00 +0000 UTC",
+		"layer:      compact integer",
+		"layout:     20060102",
+		"kind:       wall-clock",
+		"zone:       none in the input: local time (UTC, UTC+00:00)",
+		"date order: none",
+		"strftime:   %Y%m%d",
+		"go layout:  20060102",
+	}
+	if got := detectionLines(d); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("detectionLines() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	d.Layer, d.AnchorLayer = DateTimeMate.LayerDateMath, DateTimeMate.LayerRelativeWord
+	if got := detectionLines(d)[2]; got != "layer:      date math, anchor read as: relative word" {
+		t.Errorf("detectionLines() layer = %q", got)
+	}
+}
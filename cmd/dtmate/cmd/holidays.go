@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var holidaysCmd = &cobra.Command{
+	Use:   "holidays",
+	Short: "List the holidays observed in a year",
+	Example: `  dtmate holidays --year 2026 --set US
+  dtmate holidays --set UK,company.ics
+  dtmate holidays --list`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if optHolidaysList {
+			fmt.Println(strings.Join(DateTimeMate.HolidaySetNames(), "\n"))
+			return
+		}
+		outputHolidays(optHolidaysYear, optHolidaysSets, optHolidaysFormat)
+	},
+}
+
+var (
+	optHolidaysYear   int
+	optHolidaysSets   []string
+	optHolidaysFormat string
+	optHolidaysList   bool
+)
+
+func init() {
+	rootCmd.AddCommand(holidaysCmd)
+	holidaysCmd.Flags().IntVarP(&optHolidaysYear, "year", "y", 0, "year to list (default the current year)")
+	holidaysCmd.Flags().StringSliceVarP(&optHolidaysSets, "set", "S", nil, "comma-separated built-in sets (e.g. US), .json/.csv/.ics holiday files, or dates")
+	holidaysCmd.Flags().StringVarP(&optHolidaysFormat, "format", "f", "%Y-%m-%d %a", "output dates with strftime formatting")
+	holidaysCmd.Flags().BoolVarP(&optHolidaysList, "list", "l", false, "list the built-in holiday sets and exit")
+	holidaysCmd.MarkFlagsOneRequired("set", "list")
+	holidaysCmd.MarkFlagsMutuallyExclusive("set", "list")
+}
+
+func outputHolidays(year int, sets []string, format string) {
+	if year == 0 {
+		year = currentTime().Year()
+	}
+	cal, err := DateTimeMate.LoadHolidayCalendar(sets...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	holidays := cal.Holidays(year)
+	// name each holiday's set only when more than one set contributes
+	showSet := false
+	for _, h := range holidays {
+		showSet = showSet || h.Set != holidays[0].Set
+	}
+	var lines []string
+	for _, h := range holidays {
+		line, err := formatHoliday(h, format, showSet, DateTimeMate.FormatWithLocale(outputLocale()))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		lines = append(lines, line)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(lines, delim))
+	if !optRootNoNewline && len(lines) > 0 {
+		fmt.Println()
+	}
+}
+
+// formatHoliday renders one holiday as its formatted observed date and
+// name, noting the actual date of a holiday observed on another day and,
+// when showSet is true, the set it came from
+func formatHoliday(h DateTimeMate.Holiday, format string, showSet bool, options ...DateTimeMate.OptionsFormat) (string, error) {
+	date, err := DateTimeMate.FormatTime(h.Date, format, options...)
+	if err != nil {
+		return "", err
+	}
+	name := h.Name
+	if name == "" {
+		name = "holiday"
+	}
+	if h.Observed() {
+		actual, err := DateTimeMate.FormatTime(h.Actual, format, options...)
+		if err != nil {
+			return "", err
+		}
+		name += " (observed; falls on " + actual + ")"
+	}
+	if showSet {
+		name += " [" + h.Set + "]"
+	}
+	return date + "  " + name, nil
+}
@@ -0,0 +1,39 @@
+// holidays_test.go verifies how the 'holidays' sub-command renders each
+// holiday: the formatted observed date, the actual date of a holiday moved
+// off a weekend, and the set name when several sets are listed.
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jftuga/DateTimeMate"
+)
+
+func TestFormatHoliday(t *testing.T) {
+	observed := time.Date(2026, 7, 3, 0, 0, 0, 0, time.Local)
+	actual := time.Date(2026, 7, 4, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		name    string
+		h       DateTimeMate.Holiday
+		showSet bool
+		want    string
+	}{
+		{"plain", DateTimeMate.Holiday{Name: "Juneteenth", Set: "US", Date: actual, Actual: actual}, false, "2026-07-04 Sat  Juneteenth"},
+		{"observed", DateTimeMate.Holiday{Name: "Independence Day", Set: "US", Date: observed, Actual: actual}, false,
+			"2026-07-03 Fri  Independence Day (observed; falls on 2026-07-04 Sat)"},
+		{"with set", DateTimeMate.Holiday{Name: "Company Day", Set: "acme.json", Date: actual, Actual: actual}, true, "2026-07-04 Sat  Company Day [acme.json]"},
+		{"unnamed date", DateTimeMate.Holiday{Set: "dates", Date: actual, Actual: actual}, false, "2026-07-04 Sat  holiday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatHoliday(tt.h, "%Y-%m-%d %a", tt.showSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
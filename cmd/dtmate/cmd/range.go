@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var rangeCmd = &cobra.Command{
+	Use:   "range [operation] [intervals...]",
+	Short: "Merge, intersect, subtract, compare, or split time intervals written as start,end",
+	Long: `Merge, intersect, subtract, compare, or split time intervals written as start,end
+
+Operations:
+  merge, union   join overlapping and touching intervals
+  intersect      the time shared by every interval
+  subtract       the gaps left in the first interval by the others
+  overlaps       true or false for two intervals
+  contains       true or false for each date/time after the first interval
+  split          divide one interval with --parts or --step
+
+Intervals are half-open: they hold their start but not their end. The end
+may also be a duration, such as "2026-10-18 09:00,8h".`,
+	Example: `  dtmate range merge "2026-10-18 09:00,2026-10-18 11:00" "2026-10-18 10:30,2026-10-18 12:00"
+  dtmate range subtract "2026-10-19 09:00,2026-10-19 17:00" "2026-10-19 10:00,1h" "2026-10-19 13:00,90m" -f "%H:%M"
+  dtmate range split "2026-10-18 00:00,2026-10-19 00:00" --parts 3 -b
+  printf "09:00,10:00\n09:30,11:00\n" | dtmate range merge -i`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		items := args[1:]
+		if optRangeReadFromStdin {
+			lines, err := readRangeItems(os.Stdin)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			items = append(items, lines...)
+		}
+		outputRange(args[0], items)
+	},
+}
+
+var (
+	optRangeReadFromStdin bool
+	optRangeParts         int
+	optRangeStep          string
+	optRangeBrief         bool
+	optRangeFormat        string
+)
+
+func init() {
+	rootCmd.AddCommand(rangeCmd)
+	rangeCmd.Flags().BoolVarP(&optRangeReadFromStdin, "stdin", "i", false, "also read intervals (or, for contains, date/times), one per line, from STDIN")
+	rangeCmd.Flags().IntVarP(&optRangeParts, "parts", "p", 0, "with split: divide the interval into this many equal parts")
+	rangeCmd.Flags().StringVarP(&optRangeStep, "step", "s", "", "with split: divide the interval into parts of this duration, such as 1h or PT15M")
+	rangeCmd.Flags().BoolVarP(&optRangeBrief, "brief", "b", false, "output interval lengths in brief format, such as: 1h30m")
+	rangeCmd.Flags().StringVarP(&optRangeFormat, "format", "f", "", "output interval bounds with strftime formatting")
+	rangeCmd.MarkFlagsMutuallyExclusive("parts", "step")
+}
+
+// readRangeItems returns the non-blank lines of r, trimmed
+func readRangeItems(r io.Reader) ([]string, error) {
+	var items []string
+	input := bufio.NewScanner(r)
+	for input.Scan() {
+		if line := strings.TrimSpace(input.Text()); line != "" {
+			items = append(items, line)
+		}
+	}
+	if err := input.Err(); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no input on stdin: expected one start,end interval per line")
+	}
+	return items, nil
+}
+
+func outputRange(operation string, items []string) {
+	r := DateTimeMate.NewRange(
+		DateTimeMate.RangeWithOperation(operation),
+		DateTimeMate.RangeWithItems(items...),
+		DateTimeMate.RangeWithParts(optRangeParts),
+		DateTimeMate.RangeWithStep(optRangeStep),
+		DateTimeMate.RangeWithBrief(optRangeBrief),
+		DateTimeMate.RangeWithOutputFormat(optRangeFormat),
+		DateTimeMate.RangeWithLocale(outputLocale()),
+		DateTimeMate.RangeWithClock(refClock),
+		DateTimeMate.RangeWithParser(newParser()))
+	allResults, err := r.Expand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(allResults, delim))
+	if !optRootNoNewline && len(allResults) > 0 {
+		fmt.Println()
+	}
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadRangeItems(t *testing.T) {
+	got, err := readRangeItems(strings.NewReader("09:00,10:00\n\n  09:30,11:00  \n"))
+	if err != nil || !slices.Equal(got, []string{"09:00,10:00", "09:30,11:00"}) {
+		t.Errorf("readRangeItems = %q, %v; want two trimmed intervals", got, err)
+	}
+	if _, err := readRangeItems(strings.NewReader("\n \n")); err == nil {
+		t.Error("readRangeItems(blank lines) expected an error")
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var recurCmd = &cobra.Command{
+	Use:   "recur [rule]",
+	Short: "List the occurrences of an RFC 5545 recurrence rule (RRULE)",
+	Example: `  dtmate recur "FREQ=MONTHLY;BYDAY=-1FR" --from 2026-10-18 --count 4
+  dtmate recur "FREQ=WEEKLY;BYDAY=TU,TH" --from "2026-11-03 10:00" --until 2026-11-30 --exdate 2026-11-26
+  dtmate recur "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1" --count 6 -f "%Y-%m-%d %a"
+  dtmate recur "FREQ=DAILY;BYHOUR=9,17" --from "2026-03-07" --tz America/New_York --count 4`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputRecur(args[0])
+	},
+}
+
+var (
+	optRecurFrom    string
+	optRecurCount   int
+	optRecurUntil   string
+	optRecurExDates []string
+	optRecurZone    string
+	optRecurFormat  string
+)
+
+func init() {
+	rootCmd.AddCommand(recurCmd)
+	recurCmd.Flags().StringVarP(&optRecurFrom, "from", "F", "", "start of the recurrence (default the rule's DTSTART, or now)")
+	recurCmd.Flags().IntVarP(&optRecurCount, "count", "c", 0, fmt.Sprintf("output at most this many occurrences (default %d when the rule has no end and -u is not given)", DateTimeMate.DefaultRecurrenceCount))
+	recurCmd.Flags().StringVarP(&optRecurUntil, "until", "u", "", "output occurrences up to this date/time")
+	recurCmd.Flags().StringSliceVarP(&optRecurExDates, "exdate", "x", nil, "comma-separated occurrences to skip; a date without a time skips that day")
+	recurCmd.Flags().StringVarP(&optRecurZone, "tz", "z", "", "time zone whose wall clock the rule follows, such as America/New_York (default local time)")
+	recurCmd.Flags().StringVarP(&optRecurFormat, "format", "f", "", "output results with strftime formatting")
+}
+
+func outputRecur(rule string) {
+	options := []DateTimeMate.OptionsRecurrence{
+		DateTimeMate.RecurrenceWithRule(rule),
+		DateTimeMate.RecurrenceWithFrom(optRecurFrom),
+		DateTimeMate.RecurrenceWithCount(optRecurCount),
+		DateTimeMate.RecurrenceWithUntil(optRecurUntil),
+		DateTimeMate.RecurrenceWithExDates(optRecurExDates...),
+		DateTimeMate.RecurrenceWithOutputFormat(optRecurFormat),
+		DateTimeMate.RecurrenceWithLocale(outputLocale()),
+		DateTimeMate.RecurrenceWithClock(refClock),
+		DateTimeMate.RecurrenceWithParser(newParser()),
+	}
+	if optRecurZone != "" {
+		loc, err := newTimeZoneConverter().ResolveLocation(optRecurZone)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		options = append(options, DateTimeMate.RecurrenceWithLocation(loc))
+	}
+	allResults, err := DateTimeMate.NewRecurrence(options...).Expand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(allResults, delim))
+	if !optRootNoNewline && len(allResults) > 0 {
+		fmt.Println()
+	}
+}
@@ -6,34 +6,155 @@ import (
 	"github.com/spf13/cobra"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 )
 
 const extendedHelp string = `
 DURATION UNITS
-  years  weeks  days  hours  minutes  seconds  milliseconds  microseconds  nanoseconds
+  years  quarters  months  weeks  days  business days
+  hours  minutes  seconds  milliseconds  microseconds  nanoseconds
   example: '1 year 3 days 4 hours 1 minute 6 seconds'
 
 BRIEF UNITS  (date units uppercase, time units lowercase)
-  Y  W  D          years, weeks, days
+  Y  Q  M  W  D    years, quarters, months, weeks, days
+  B                business days
   h  m  s          hours, minutes, seconds
   ms us ns         milliseconds, microseconds, nanoseconds
   examples: 1Y3W4D5h6m7s8ms9us1ns  or  '1Y 3W 4D 5h 6m 7s'
 
-RELATIVE DATE SHORTCUTS
+ISO 8601 DURATIONS
+  P[nY][nM][nW][nD][T[nH][nM][nS]], accepted by dur, conv, and durmath
+  only the smallest component may be fractional: PT1.5H, P1DT0,5S
+  dur applies Y, M, W, and D on the calendar; a leading - reverses dur's -a or -s
+  examples: P1Y2M3DT4H5M6.5S  P2W  PT36H  -PT90M
+  --iso on diff, conv, and durmath outputs one, in the target units: conv 36h Dh --iso => P1DT12H
+
+RELATIVE DATES
   now, today       the current time
-  yesterday        exactly 24 hours before now
-  tomorrow         exactly 24 hours after now
+  yesterday        this time of day, one calendar day before today
+  tomorrow         this time of day, one calendar day after today
+  days             friday, next friday, last friday, next month, last monday of march,
+                   first friday of next month, end of month, beginning of next quarter
+  shifts           3 days ago, in 2 weeks, an hour ago, 2 hours from now
+  times of day     noon, midnight, 9am, at 14:30: tomorrow 9am, 3 days ago at noon
+  a named day starts at midnight; weeks start on Monday; end of is the last nanosecond
+  dtmate fmt PHRASE FORMAT --explain shows how a phrase was read
+  --now or DTMATE_NOW pins now to an absolute date/time: --now "2026-03-02 09:00:00"
   example: dtmate dur today 7h10m -a -u tomorrow
 
+DATE MATH  (Grafana style, accepted wherever a date is)
+  an anchor (now or any date) then offsets and snaps, left to right: now-7d/d  now/w  now+1M^M
+  offsets: brief units Y Q M W D h m s ms us ns; lowercase y, w, d also work
+  /unit snaps to the start, ^unit to the end, of m h d w M Q y, in the anchor's zone
+  example: dtmate diff "now-7d/d" "now/d"
+
+OUTPUT FORMATS  (fmt, tz --format, and -f on every other command)
+  strftime specifiers, listed by dtmate fmt -l, plus:
+  %L %f %N         milliseconds, microseconds, nanoseconds; also %3N %6N %9N
+  %Q %o            quarter (1-4); ordinal suffix of the day: %-d%o => 22nd
+  %s %3s %6s %9s   Unix time in seconds, milliseconds, microseconds, nanoseconds
+  example: dtmate fmt now "%F %T.%3N"
+  --layout on fmt, tz, and dur uses a Go reference layout instead: --layout "2006-01-02T15:04:05.000Z07:00"
+    or a named one: RFC3339 RFC3339Nano RFC1123Z Kitchen StampMilli DateOnly ... (listed by dtmate fmt -l)
+
+LOCALES
+  --locale sets the language of %A %a %B %b %p and the order of %c %x %X: de en es fr it nl pt
+  POSIX names such as de_DE.UTF-8 work; without --locale, LC_ALL, LC_TIME, or LANG is used
+  the names are built in, so output is the same on every machine; %o stays English
+  example: dtmate fmt 2026-07-22 "%A %-d %B %Y" --locale fr
+
 DATE PARSING
   slash dates default to US order: 01/02/2024 is January 2
   set DTMATE_DATE_ORDER=DMY for day/month/year, or MDY to silence the warning
+  set DTMATE_PARSE_LOCALES=fr,de (or all) to also read dates in those languages, day first:
+    "mardi 3 mars 2026 à 14:30", "3 de marzo de 2026", and relative words such as mañana
   pure integers: 10 digits are unix seconds, 13 unix milliseconds;
-    4, 8, and 14 digits are a year, compact date, and compact date/time
+    4, 7, 8, and 14 digits are a year, ordinal date, compact date, and compact date/time
+  ISO 8601 week dates (2026-W07-3, 2026W073, 2026-W07 for its Monday) and
+    ordinal dates (2026-045) are accepted wherever a date is
+  dtmate fmt DATE --detect shows which layer and layout read an input, its kind and zone,
+    the slash-date order decision, and the equivalent strftime format and Go layout
+  date/times without a zone are local time; --in on diff, dur, and fmt reads them in another
+    zone, resolved like a tz target: dtmate diff "2026-03-08 01:00" "2026-03-08 04:00" --in America/Chicago
+  --tz on dur and fmt converts results to a zone before formatting; on diff it shows the endpoints there
+    date/times before 1970 need --tz-force, as with tz --force: dtmate dur now 1D -a -r 3 --tz Asia/Tokyo
+
+MONTHS AND QUARTERS
+  dur applies months and quarters (3 months) on the calendar; amounts must be whole
+  --end-of-month overflow (default) rolls Jan 31 + 1M into March, like Feb 29 + 1Y = Mar 1
+  --end-of-month clamp pins to the month's last day: Jan 31 + 1M = Feb 29, Feb 29 + 1Y = Feb 28
+  example: dtmate dur 2024-01-31 1M -a -r 3 --end-of-month clamp
+
+BUSINESS DAYS
+  dur counts business days on the calendar, skipping the weekend and holidays
+  --weekend sets the skipped weekdays (default sat,sun), e.g. fri,sat or fri-sat
+  --holidays lists holiday sets, files, or dates to skip, e.g. --holidays US,2026-12-24
+  example: dtmate dur 2026-10-16 10B -a
+
+BUSINESS HOURS
+  diff and dur --business-hours count only working time, default "mon-fri 09:00-17:00"
+  attach a custom schedule with "=": --business-hours="mon-thu 08:00-12:00,13:00-17:00; fri 08:00-12:00"
+  --business-zone sets the schedule's time zone; --holidays removes whole days
+  example: dtmate dur "2026-10-16 15:00" 16h -a --business-hours --business-zone America/Chicago
+
+HOLIDAYS
+  built-in sets: US, UK, CA, DE, FR; weekend holidays move to their observed weekday
+  files: .json (dates or rules), .csv (date,name rows), .ics (events, yearly RRULEs)
+  example: dtmate holidays --year 2026 --set US,company.csv
+
+RECURRENCE
+  recur lists the occurrences of an RFC 5545 RRULE: FREQ, INTERVAL, COUNT, UNTIL,
+    BYDAY (MO, 2TU, -1FR), BYMONTHDAY, BYMONTH, BYYEARDAY, BYSETPOS, BYHOUR, BYMINUTE, BYSECOND, WKST
+  --tz keeps the wall clock across DST; --exdate skips occurrences or whole days
+  dur accepts an RRULE in place of the duration: dtmate dur today "FREQ=MONTHLY;BYDAY=-1FR" -a -r 3
+  example: dtmate recur "FREQ=MONTHLY;BYDAY=-1FR" --from 2026-10-18 --count 4
+
+CRON
+  cron lists the runs of a 5-field expression, a 6-field one with leading seconds, or a macro
+    such as @daily, @hourly, @weekly; extensions: L, L-3, 15W, LW, 5L (last Friday), TUE#1
+  --prev lists past runs; --explain describes the expression in plain English
+  fixed-time jobs skipped by DST run when the gap ends; wildcard jobs run in both repeated hours
+  example: dtmate cron "*/15 9-17 * * MON-FRI" --count 5 --tz Europe/Berlin
+
+WEEKS
+  week outputs a week's first and last days, or the week holding a date, under each rule
+  iso: weeks start on Monday, week 1 holds the first Thursday; us: Sunday, week 1 holds Jan 1
+  --first-day and --min-days define a custom rule: week 1 holds at least min-days of the year
+  example: dtmate week 2026-W07 --rule iso,us
+
+FISCAL CALENDARS
+  fiscal outputs the fiscal year, quarter, period, and week of a date, or the bounds of
+    FY2027, FY2027-Q1, FY2027-P02, or FY2027-W06
+  --start-month sets the year's first month; periods are calendar months unless --pattern
+    4-4-5, 4-5-4, or 5-4-4 gives 52 or 53 whole weeks from the --first-day nearest the 1st
+  years are labeled by the calendar year they end in unless --label start is given
+  example: dtmate fiscal 2026-11-03 --start-month 10
+
+INTERVALS
+  range takes intervals written as start,end; the end may be a duration: "2026-10-19 09:00,90m"
+  intervals hold their start but not their end, so back-to-back intervals do not overlap
+  operations: merge, union, intersect, subtract (from the first), overlaps, contains, split
+  split takes --parts N or --step DURATION; -i reads intervals from STDIN, one per line
+  example: dtmate range subtract "2026-10-19 09:00,2026-10-19 17:00" "2026-10-19 12:00,1h"
+
+WORLD CLOCK
+  tz with comma-separated zones prints a table: local time, offset, abbreviation, DST, and
+    +1 or -1 when a zone's date differs from the date/time's own date
+  --group NAME uses the zones listed under [zones] in the configuration file; --watch redraws every second
+  example: dtmate tz "15:00 UTC" Europe/London,Asia/Kolkata,America/Denver
+
+CONFIGURATION
+  --config, else DTMATE_CONFIG, else $XDG_CONFIG_HOME/dtmate/config (~/.config/dtmate/config)
+  key = value lines: date-order, zone-aliases, week-start, input-zone, output-zone, brief,
+    a [format] section keyed by command: dur = %F %T sets dur's --format,
+    and a [zones] section of groups for tz --group: team = Europe/London,Asia/Kolkata
+  a flag overrides the environment, which overrides the file, which overrides the default
+  example: dtmate config show
 
 CONVERSION NOTES
   1 year equals 365.25 days
-  months are not a unit; their lengths vary between 28 and 31 days
+  conv and durmath reject months, quarters, and business days; their lengths vary
   durations are limited to about +/-292 years
   a lone ns or us target means that sub-second unit; a lone ms target
     means minutes+seconds and warns (use .ms for milliseconds)
@@ -55,7 +176,7 @@ var rootCmd = &cobra.Command{
 		}
 		if optRootHelpAll {
 			cmd.Help() //nolint:errcheck
-			fmt.Print(extendedHelp)
+			fmt.Printf("%s", extendedHelp)
 			os.Exit(0)
 		}
 
@@ -70,8 +191,69 @@ var rootCmd = &cobra.Command{
 var optRootNoNewline bool
 var optRootShowExamples bool
 var optRootHelpAll bool
+var optRootLocale string
+var optRootNow string
+
+// nowEnvVar names the environment variable pinning the reference instant for
+// a whole script or test suite, overridden by --now
+const nowEnvVar = "DTMATE_NOW"
+
+// refClock is the reference instant set by pinNow and passed to every
+// command's parsing: fixed by --now or nowEnvVar, or else the system time
+var refClock = DateTimeMate.SystemClock
 var readmeExamplesRegex = regexp.MustCompile(`(?ms)## Command Line Examples.*?shell\n(.*?)` + "```")
 
+// outputLocale returns the --locale flag or, when it is not given, the
+// locale named by LC_ALL, LC_TIME, or LANG, whichever is set first; an
+// environment locale without built-in names, such as ja_JP.UTF-8, means
+// English rather than an error
+func outputLocale() string {
+	if optRootLocale != "" {
+		return optRootLocale
+	}
+	for _, name := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			language, _ := DateTimeMate.ParseLocale(value)
+			return language
+		}
+	}
+	return ""
+}
+
+// pinNow pins refClock to the instant in --now or nowEnvVar and rejects an
+// invalid instant up front, whichever held it
+func pinNow() {
+	if err := setNow(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// setNow is pinNow returning its error, which names --now or nowEnvVar,
+// whichever held the invalid instant
+func setNow() error {
+	name, value := "--now", optRootNow
+	if value == "" {
+		name, value = nowEnvVar, strings.TrimSpace(os.Getenv(nowEnvVar))
+	}
+	refClock = DateTimeMate.SystemClock
+	if value == "" {
+		return nil
+	}
+	now, err := DateTimeMate.ParseNow(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	refClock = DateTimeMate.FixedClock(now)
+	return nil
+}
+
+// currentTime returns the reference instant pinned by pinNow, or the
+// system time
+func currentTime() time.Time {
+	return refClock.Now()
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -82,7 +264,16 @@ func Execute() {
 }
 
 func init() {
+	// set here rather than in rootCmd's literal, since the configuration's
+	// settings refer back to rootCmd's commands
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		applyConfig(cmd)
+		pinNow()
+	}
 	rootCmd.PersistentFlags().BoolVarP(&optRootNoNewline, "nonewline", "n", false, "do not output a newline character")
+	rootCmd.PersistentFlags().StringVar(&optRootLocale, "locale", "", fmt.Sprintf("language of month and weekday names in formatted output: %s (default from LC_ALL, LC_TIME, or LANG)", strings.Join(DateTimeMate.Locales(), ", ")))
+	rootCmd.PersistentFlags().StringVar(&optRootConfig, "config", "", fmt.Sprintf("configuration file (default from %s, else $XDG_CONFIG_HOME/dtmate/config)", configEnvVar))
+	rootCmd.PersistentFlags().StringVar(&optRootNow, "now", "", fmt.Sprintf("reference instant for now, relative dates, and bare times, such as \"2026-03-02 09:00:00\" (default from %s, else the system time)", nowEnvVar))
 	rootCmd.Flags().BoolVarP(&optRootShowExamples, "examples", "e", false, "show command-line examples")
 	rootCmd.Flags().BoolVar(&optRootHelpAll, "help-all", false, "show help plus duration syntax, brief units, and conversion notes")
 
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOutputLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		flag   string
+		lcAll  string
+		lcTime string
+		lang   string
+		want   string
+	}{
+		{"nothing set", "", "", "", "", ""},
+		{"flag wins", "it", "fr_FR.UTF-8", "", "", "it"},
+		{"LC_ALL before LC_TIME", "", "de_DE.UTF-8", "fr_FR.UTF-8", "", "de"},
+		{"LC_TIME before LANG", "", "", "fr_FR.UTF-8", "nl_NL.UTF-8", "fr"},
+		{"LANG", "", "", "", "pt_BR.UTF-8", "pt"},
+		{"C locale", "", "C", "fr_FR.UTF-8", "", "en"},
+		{"unsupported language is English", "", "", "ja_JP.UTF-8", "fr_FR.UTF-8", ""},
+	}
+	defer func() { optRootLocale = "" }()
+	for _, tt := range tests {
+		optRootLocale = tt.flag
+		t.Setenv("LC_ALL", tt.lcAll)
+		t.Setenv("LC_TIME", tt.lcTime)
+		t.Setenv("LANG", tt.lang)
+		if got := outputLocale(); got != tt.want {
+			t.Errorf("%s: outputLocale() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetNow(t *testing.T) {
+	defer func() { optRootNow = "" }()
+	tests := []struct {
+		flag, env, want string
+	}{
+		{"garbage", "", `--now: unable to parse date/time: "garbage"`},
+		{"tomorrow", "2026-03-02 09:00:00", "--now: "},
+		{"", "garbage", nowEnvVar + ": "},
+	}
+	for _, tt := range tests {
+		optRootNow = tt.flag
+		t.Setenv(nowEnvVar, tt.env)
+		if err := setNow(); err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+			t.Errorf("setNow() with --now %q and %s=%q: err = %v, want %q", tt.flag, nowEnvVar, tt.env, err, tt.want)
+		}
+	}
+
+	// a valid --now overrides the environment
+	optRootNow = "2026-03-02 09:00:00"
+	t.Setenv(nowEnvVar, "garbage")
+	if err := setNow(); err != nil {
+		t.Fatalf("setNow() with a valid --now: %v", err)
+	}
+	if now := currentTime(); now.Format("2006-01-02 15:04:05") != "2026-03-02 09:00:00" {
+		t.Errorf("currentTime() after setNow = %v", now)
+	}
+
+	optRootNow = ""
+	t.Setenv(nowEnvVar, "1704085262")
+	if err := setNow(); err != nil || currentTime().Unix() != 1704085262 {
+		t.Errorf("setNow() with %s=1704085262: %v, currentTime() = %v", nowEnvVar, err, currentTime())
+	}
+
+	// neither set is the system time
+	t.Setenv(nowEnvVar, "")
+	if err := setNow(); err != nil || time.Since(currentTime()) > time.Minute {
+		t.Errorf("setNow() with neither set: %v, currentTime() = %v", err, currentTime())
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"maps"
 	"os"
 	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jftuga/DateTimeMate"
 	"github.com/spf13/cobra"
@@ -16,14 +18,31 @@ var optTzListZones bool
 var optTzListIANA bool
 var optTzForce bool
 var optTzFormat string
+var optTzLayout string
+var optTzGroup string
+var optTzWatch bool
 
 var tzCmd = &cobra.Command{
-	Use:   "tz [date/time] [target time zone]",
+	Use:   "tz [date/time] [target time zone[,zone...]]",
 	Short: "Convert a date/time from one time zone to another",
+	Long: `Convert a date/time from one time zone to another.
+
+Given several comma-separated target zones, or a --group of zones named in
+the [zones] section of the configuration file, tz prints a world clock: a
+table of the local time, UTC offset, abbreviation, and daylight saving time
+of each zone, marking a zone whose date is a day ahead of (+1) or behind (-1)
+the date/time's own date. --watch redraws the table every second, so that
+"now" stays current.`,
+	Example: `  dtmate tz "2024-01-15 12:00:00 UTC" America/New_York
+  dtmate tz "15:00 UTC" Europe/London,Asia/Kolkata,America/Denver
+  dtmate tz now --group team --watch`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if optTzListZones || optTzListIANA {
 			return cobra.NoArgs(cmd, args)
 		}
+		if optTzGroup != "" {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
 		return cobra.ExactArgs(2)(cmd, args)
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -35,7 +54,20 @@ var tzCmd = &cobra.Command{
 			listIANAZones()
 			return
 		}
-		outputTzConversion(args[0], args[1])
+		if optTzGroup != "" {
+			outputWorldClock(args[0], zoneGroup(optTzGroup))
+			return
+		}
+		zones := splitZones(args[1])
+		if len(zones) > 1 || optTzWatch {
+			outputWorldClock(args[0], zones)
+			return
+		}
+		target := args[1]
+		if len(zones) == 1 {
+			target = zones[0]
+		}
+		outputTzConversion(args[0], target)
 	},
 }
 
@@ -45,11 +77,120 @@ func init() {
 	tzCmd.Flags().BoolVarP(&optTzListIANA, "list-iana", "I", false, "list the IANA time zone names (e.g. America/New_York) and exit")
 	tzCmd.Flags().BoolVarP(&optTzForce, "force", "f", false, "convert date/times before 1970 despite unreliable time zone data")
 	tzCmd.Flags().StringVar(&optTzFormat, "format", "", "output results with strftime formatting")
+	tzCmd.Flags().StringVar(&optTzLayout, "layout", "", "output results with a Go reference layout or a named layout such as RFC3339")
+	tzCmd.Flags().StringVarP(&optTzGroup, "group", "g", "", "convert to the zones of a group named in the [zones] section of the configuration file")
+	tzCmd.Flags().BoolVarP(&optTzWatch, "watch", "w", false, "redraw the world clock every second until interrupted")
 	tzCmd.MarkFlagsMutuallyExclusive("list-zones", "list-iana")
 }
 
+// splitZones splits a comma-separated list of time zones, dropping blanks
+func splitZones(list string) []string {
+	var zones []string
+	for _, zone := range strings.Split(list, ",") {
+		if zone = strings.TrimSpace(zone); zone != "" {
+			zones = append(zones, zone)
+		}
+	}
+	return zones
+}
+
+// zoneGroup returns the zones of a group in the configuration file
+func zoneGroup(name string) []string {
+	entry, ok := configEntry(zoneGroupPrefix + strings.ToLower(name))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "no zone group %q: define it in the [zones] section of %s\n", name, configPath())
+		os.Exit(1)
+	}
+	return splitZones(entry.Value)
+}
+
+// worldClockLines renders a world clock table with a header row; render
+// formats each zone's local time
+func worldClockLines(rows []DateTimeMate.ZoneTime, render func(time.Time) (string, error)) ([]string, error) {
+	table := [][]string{{"ZONE", "TIME", "OFFSET", "ABBR", "DST", "DAY"}}
+	for _, row := range rows {
+		local, err := render(row.Time)
+		if err != nil {
+			return nil, err
+		}
+		dst, day := "no", ""
+		if row.DST {
+			dst = "yes"
+		}
+		if row.DayShift != 0 {
+			day = fmt.Sprintf("%+d", row.DayShift)
+		}
+		table = append(table, []string{row.Zone, local, "UTC" + DateTimeMate.FormatUTCOffset(row.Offset), row.Abbreviation, dst, day})
+	}
+	widths := make([]int, len(table[0]))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	lines := make([]string, 0, len(table))
+	for _, cells := range table {
+		var b strings.Builder
+		for i, cell := range cells {
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	return lines, nil
+}
+
+// outputWorldClock prints the world clock of source in zones, redrawing it
+// every second with --watch
+func outputWorldClock(source string, zones []string) {
+	tz := newTimeZoneConverter()
+	seen := map[string]bool{}
+	for _, zone := range zones {
+		for _, warning := range tz.Warnings(source, zone) {
+			if !seen[warning] {
+				fmt.Fprintln(os.Stderr, "warning:", warning)
+				seen[warning] = true
+			}
+		}
+	}
+	render := func(t time.Time) (string, error) {
+		if optTzFormat == "" && optTzLayout == "" {
+			return t.Format("2006-01-02 15:04:05"), nil
+		}
+		return DateTimeMate.FormatTime(t, optTzFormat,
+			DateTimeMate.FormatWithLocale(outputLocale()), DateTimeMate.FormatWithLayout(optTzLayout))
+	}
+	for {
+		_, rows, err := tz.ConvertToZones(source, zones)
+		if err != nil {
+			exitWithConversionError(err, "force")
+		}
+		lines, err := worldClockLines(rows, render)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if !optTzWatch {
+			delim := "\n"
+			if optRootNoNewline {
+				delim = ","
+			}
+			fmt.Print(strings.Join(lines, delim))
+			if !optRootNoNewline {
+				fmt.Println()
+			}
+			return
+		}
+		// clear the screen and move the cursor home before each redraw
+		fmt.Print("\033[H\033[2J" + strings.Join(lines, "\n") + "\n")
+		time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	}
+}
+
 func newTimeZoneConverter() *DateTimeMate.TimeZoneConverter {
-	aliases, err := DateTimeMate.ParseZoneAliases(os.Getenv(DateTimeMate.ZoneAliasesEnvVar))
+	aliases, err := zoneAliases()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s: %v\n", DateTimeMate.ZoneAliasesEnvVar, err)
 		os.Exit(1)
@@ -57,26 +198,62 @@ func newTimeZoneConverter() *DateTimeMate.TimeZoneConverter {
 	return DateTimeMate.NewTimeZoneConverter(
 		DateTimeMate.TimeZoneConverterWithZoneAbbrevs(DateTimeMate.LoadZoneDefinitions()),
 		DateTimeMate.TimeZoneConverterWithAliases(aliases),
-		DateTimeMate.TimeZoneConverterWithAllowPre1970(optTzForce))
+		DateTimeMate.TimeZoneConverterWithAllowPre1970(optTzForce),
+		DateTimeMate.TimeZoneConverterWithClock(refClock),
+		DateTimeMate.TimeZoneConverterWithParser(newParser()))
+}
+
+// addInputZoneFlag registers the --in flag, the time zone of date/times
+// given without one
+func addInputZoneFlag(cmd *cobra.Command, zone *string) {
+	cmd.Flags().StringVar(zone, "in", "", "time zone of date/times without one: an IANA name, abbreviation, alias, or UTC offset in seconds, such as America/Chicago, CET, or 19800 (default local time)")
+}
+
+// addOutputZoneFlags registers the --tz flag, the time zone results are
+// converted to, and --tz-force, which converts date/times before 1970
+func addOutputZoneFlags(cmd *cobra.Command, zone *string, force *bool) {
+	cmd.Flags().StringVar(zone, "tz", "", "convert results to this time zone, resolved like a tz target, such as Asia/Tokyo (default the zone of the input)")
+	cmd.Flags().BoolVar(force, "tz-force", false, "with --tz: convert date/times before 1970 despite unreliable time zone data")
+}
+
+// outputZoneConverter returns the converter for --tz, warning about an
+// ambiguous zone abbreviation, or nil when --tz is not given
+func outputZoneConverter(zone string, force bool) *DateTimeMate.TimeZoneConverter {
+	if zone == "" {
+		return nil
+	}
+	tz := newTimeZoneConverter()
+	tz.AllowPre1970 = force
+	for _, warning := range tz.Warnings("", zone) {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+	return tz
+}
+
+// exitWithConversionError prints err, naming forceFlag when a date/time
+// before 1970 was refused, and exits
+func exitWithConversionError(err error, forceFlag string) {
+	if errors.Is(err, DateTimeMate.ErrPre1970) {
+		fmt.Fprintln(os.Stderr, err, "(use --"+forceFlag+" to convert anyway)")
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(1)
 }
 
 func outputTzConversion(source, target string) {
 	tz := newTimeZoneConverter()
 	result, err := tz.ConvertTimeZone(source, target)
 	if err != nil {
-		if errors.Is(err, DateTimeMate.ErrPre1970) {
-			fmt.Fprintln(os.Stderr, err, "(use --force to convert anyway)")
-		} else {
-			fmt.Fprintln(os.Stderr, err)
-		}
-		os.Exit(1)
+		exitWithConversionError(err, "force")
 	}
 	for _, warning := range tz.Warnings(source, target) {
 		fmt.Fprintln(os.Stderr, "warning:", warning)
 	}
 	formatted := result.Format("2006-01-02 15:04:05 -0700 MST")
-	if optTzFormat != "" {
-		formatted, err = DateTimeMate.FormatTime(result, optTzFormat)
+	if optTzFormat != "" || optTzLayout != "" {
+		formatted, err = DateTimeMate.FormatTime(result, optTzFormat,
+			DateTimeMate.FormatWithLocale(outputLocale()), DateTimeMate.FormatWithLayout(optTzLayout))
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
@@ -112,7 +289,7 @@ func listZones() {
 // listIANAZones prints each IANA zone name with the UTC offset and
 // abbreviation currently in effect there
 func listIANAZones() {
-	now := time.Now()
+	now := currentTime()
 	fmt.Printf("offsets and abbreviations are those currently in effect (%s)\n", now.Format("2006-01-02"))
 	for _, name := range DateTimeMate.ListIANAZones() {
 		loc, err := time.LoadLocation(name)
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jftuga/DateTimeMate"
+)
+
+func TestSplitZones(t *testing.T) {
+	got := splitZones(" Europe/London,, Asia/Kolkata ,19800,")
+	if want := []string{"Europe/London", "Asia/Kolkata", "19800"}; !slices.Equal(got, want) {
+		t.Errorf("splitZones = %q, want %q", got, want)
+	}
+	if got := splitZones("Europe/London,"); !slices.Equal(got, []string{"Europe/London"}) {
+		t.Errorf("splitZones with a trailing comma = %q, want [Europe/London]", got)
+	}
+	if got := splitZones(" , "); len(got) != 0 {
+		t.Errorf("splitZones of blanks = %q, want none", got)
+	}
+}
+
+func TestWorldClockLines(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []DateTimeMate.ZoneTime{
+		{Zone: "Europe/London", Time: time.Date(2026, 10, 18, 16, 0, 0, 0, london), Abbreviation: "BST", Offset: 3600, DST: true},
+		{Zone: "JST", Time: time.Date(2026, 10, 19, 0, 0, 0, 0, time.FixedZone("JST", 32400)), Abbreviation: "JST", Offset: 32400, DayShift: 1},
+	}
+	lines, err := worldClockLines(rows, func(t time.Time) (string, error) { return t.Format("Mon 15:04"), nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"ZONE           TIME       OFFSET     ABBR  DST  DAY",
+		"Europe/London  Sun 16:00  UTC+01:00  BST   yes",
+		"JST            Mon 00:00  UTC+09:00  JST   no   +1",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("worldClockLines =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
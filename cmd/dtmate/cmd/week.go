@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jftuga/DateTimeMate"
+	"github.com/spf13/cobra"
+)
+
+var weekCmd = &cobra.Command{
+	Use:   "week [week or date]",
+	Short: "Output a week's first and last days, or a date's week number, under ISO, US, or custom week rules",
+	Example: `  dtmate week 2026-W07
+  dtmate week 2026-12-31 --rule iso
+  dtmate week today --rule us -f "%m/%d"
+  dtmate week 2026-01-02 --first-day sat --min-days 1`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outputWeek(cmd, args[0])
+	},
+}
+
+var (
+	optWeekRules    []string
+	optWeekFirstDay string
+	optWeekMinDays  int
+	optWeekFormat   string
+)
+
+func init() {
+	rootCmd.AddCommand(weekCmd)
+	weekCmd.Flags().StringSliceVarP(&optWeekRules, "rule", "r", nil, "comma-separated week rules: iso, us (default iso,us unless a custom rule is given)")
+	weekCmd.Flags().StringVar(&optWeekFirstDay, "first-day", "mon", "first day of the week for a custom rule")
+	weekCmd.Flags().IntVar(&optWeekMinDays, "min-days", 4, "minimum days of the new year in week 1 for a custom rule, 1-7")
+	weekCmd.Flags().StringVarP(&optWeekFormat, "format", "f", DateTimeMate.DefaultWeekOutputFormat, "output the first and last days with strftime formatting")
+}
+
+// weekRules returns the rules named by --rule, followed by a custom rule
+// when --first-day or --min-days is given, or week-start is configured; a
+// configured week-start adds its rule to the default iso and us rules,
+// while the flags on the command line ask for the custom rule alone
+func weekRules(cmd *cobra.Command) ([]DateTimeMate.WeekRule, error) {
+	names := optWeekRules
+	if len(names) == 0 && configFlags["first-day"] && !cmd.Flags().Changed("min-days") {
+		names = []string{"iso", "us"}
+	}
+	var rules []DateTimeMate.WeekRule
+	for _, name := range names {
+		rule, err := DateTimeMate.ParseWeekRule(name)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if cmd.Flags().Changed("first-day") || cmd.Flags().Changed("min-days") || configFlags["first-day"] {
+		rule, err := DateTimeMate.NewWeekRule(optWeekFirstDay, optWeekMinDays)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func outputWeek(cmd *cobra.Command, source string) {
+	rules, err := weekRules(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	week := DateTimeMate.NewWeek(
+		DateTimeMate.WeekWithSource(source),
+		DateTimeMate.WeekWithRules(rules...),
+		DateTimeMate.WeekWithOutputFormat(optWeekFormat),
+		DateTimeMate.WeekWithLocale(outputLocale()),
+		DateTimeMate.WeekWithClock(refClock),
+		DateTimeMate.WeekWithParser(newParser()))
+	allResults, err := week.Expand()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	delim := "\n"
+	if optRootNoNewline {
+		delim = ","
+	}
+	fmt.Print(strings.Join(allResults, delim))
+	if !optRootNoNewline && len(allResults) > 0 {
+		fmt.Println()
+	}
+}
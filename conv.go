@@ -35,6 +35,17 @@ var unitNanos = map[string]int64{
 	"year":        nanosPerYear,
 }
 
+// variableLengthUnits are calendar units whose length depends on the date
+// they are applied to; dur applies them calendar-correctly, but conv and
+// durmath work in fixed nanoseconds and reject them; business days are
+// keyed without their space, as "5 business days" and the brief "5B" both
+// normalize to "businessday"
+var variableLengthUnits = map[string]bool{
+	"month":       true,
+	"quarter":     true,
+	"businessday": true,
+}
+
 var unitBriefMap = map[string]string{
 	"ns": "nanosecond",
 	"us": "microsecond",
@@ -45,6 +56,8 @@ var unitBriefMap = map[string]string{
 	"h":  "hour",
 	"D":  "day",
 	"W":  "week",
+	"M":  "month",
+	"Q":  "quarter",
 	"Y":  "year",
 }
 
@@ -58,6 +71,7 @@ type Conv struct {
 	Source   string
 	Target   string
 	Brief    bool
+	ISO      bool
 	Decimals int
 }
 
@@ -89,6 +103,14 @@ func ConvWithBrief(brief bool) OptionsConv {
 	}
 }
 
+// ConvWithISO renders the result as an ISO 8601 duration, such as
+// "P1DT12H", built from the target units
+func ConvWithISO(iso bool) OptionsConv {
+	return func(conv *Conv) {
+		conv.ISO = iso
+	}
+}
+
 // ConvWithDecimals sets the number of decimal places used when formatting
 // the last (smallest) target unit; 0 keeps the default integer truncation
 func ConvWithDecimals(decimals int) OptionsConv {
@@ -98,7 +120,7 @@ func ConvWithDecimals(decimals int) OptionsConv {
 }
 
 func (conv *Conv) String() string {
-	return fmt.Sprintf("Source:%v Target:%v Brief:%v Decimals:%v", conv.Source, conv.Target, conv.Brief, conv.Decimals)
+	return fmt.Sprintf("Source:%v Target:%v Brief:%v ISO:%v Decimals:%v", conv.Source, conv.Target, conv.Brief, conv.ISO, conv.Decimals)
 }
 
 // normalizeUnit lowercases a unit name and strips a plural trailing "s"
@@ -125,6 +147,21 @@ func isValidAmount(s string) bool {
 // be represented in int64 nanoseconds
 var errDurationRange = errors.New("duration exceeds the supported range of about 292 years")
 
+// variableLengthReasons names each variable-length unit as a user would
+// and says why its length depends on the date
+var variableLengthReasons = map[string]struct{ name, reason string }{
+	"month":       {"months", "a month is 28 to 31 days long"},
+	"quarter":     {"quarters", "a quarter is 90 to 92 days long"},
+	"businessday": {"business days", "business days skip weekends, so their elapsed time depends on the day of the week"},
+}
+
+// variableLengthUnitError explains why a month, quarter, or business day,
+// given by its normalized key, cannot be used as a fixed-length duration unit
+func variableLengthUnitError(unit string) error {
+	r := variableLengthReasons[unit]
+	return fmt.Errorf("%s have no fixed length (%s); use years, weeks, or days, or add them to a date with dur", r.name, r.reason)
+}
+
 // addInt64Checked adds two int64 values, erroring on overflow
 func addInt64Checked(a, b int64) (int64, error) {
 	sum := a + b
@@ -161,14 +198,26 @@ func amountToNanos(amount string, unitNs int64) (int64, error) {
 // parseDurationNanos converts a duration string to a total number of
 // nanoseconds.
 //
-// The source may be in long form ("1 hour 30 minutes") or brief form ("1h30m");
-// brief input is first expanded to long form. Long form must contain alternating
+// The source may be in long form ("1 hour 30 minutes"), brief form ("1h30m"),
+// or ISO 8601 form ("PT1H30M"); brief and ISO 8601 input is first expanded
+// to long form, and a negative ISO 8601 duration yields a negative total. Long form must contain alternating
 // numeric values and time unit strings, with units (defined in unitNanos)
 // accepted in both singular and plural forms. Integral amounts convert
 // exactly; fractional amounts carry float64 precision (about 15-16
 // significant digits). The total must fit in int64 nanoseconds, about
 // +/-292 years.
 func parseDurationNanos(source string) (int64, error) {
+	if isISODuration(source) {
+		expandedSource, negative, err := expandISODuration(source)
+		if err != nil {
+			return 0, err
+		}
+		total, err := parseDurationNanos(expandedSource)
+		if negative {
+			total = -total
+		}
+		return total, err
+	}
 	if !isLongFormDuration(strings.Fields(source)) {
 		// brief format is being used so convert to long duration format
 		expandedSource, err := expandBriefSourceDuration(source)
@@ -185,6 +234,9 @@ func parseDurationNanos(source string) (int64, error) {
 			return 0, fmt.Errorf("missing unit after %q in: %s", parts[i], source)
 		}
 		unit := normalizeUnit(parts[i+1])
+		if variableLengthUnits[unit] {
+			return 0, variableLengthUnitError(unit)
+		}
 		unitNs, ok := unitNanos[unit]
 		if !ok {
 			return 0, fmt.Errorf("unknown source unit: %q", parts[i+1])
@@ -217,7 +269,7 @@ func resolveTargetUnits(target string) ([]string, error) {
 		return nil, fmt.Errorf("no target units specified")
 	}
 	if len(targetUnits) == 1 {
-		if _, ok := unitNanos[normalizeUnit(target)]; !ok {
+		if _, ok := unitNanos[normalizeUnit(target)]; !ok && !variableLengthUnits[normalizeUnit(target)] {
 			// brief format is being used so convert to long duration format
 			var err error
 			targetUnits, err = expandBriefTargetDuration(target)
@@ -230,6 +282,9 @@ func resolveTargetUnits(target string) ([]string, error) {
 		}
 	}
 	for _, unit := range targetUnits {
+		if variableLengthUnits[normalizeUnit(unit)] {
+			return nil, variableLengthUnitError(normalizeUnit(unit))
+		}
 		if _, ok := unitNanos[normalizeUnit(unit)]; !ok {
 			return nil, fmt.Errorf("unknown target unit: %q", unit)
 		}
@@ -456,7 +511,8 @@ func expandBriefTargetDuration(period string) ([]string, error) {
 // 2. Parses the Source string to calculate the total duration in nanoseconds.
 // 3. If the Target is in brief format, it expands it to a list of unit names.
 // 4. Formats the duration according to the specified target units.
-// 5. If Conv.Brief is true, it converts the result back to brief format.
+// 5. If Conv.Brief is true, it converts the result back to brief format;
+// if Conv.ISO is true, it renders the result as an ISO 8601 duration.
 //
 // The function handles both brief (e.g., "1h30m") and long (e.g., "1 hour 30 minutes") formats
 // for both input and output. A leading "-" negates the whole source, and a
@@ -470,6 +526,9 @@ func (conv *Conv) ConvertDuration() (string, error) {
 	if conv.Decimals < 0 || conv.Decimals > 9 {
 		return "", fmt.Errorf("decimals must be between 0 and 9: %d", conv.Decimals)
 	}
+	if conv.Brief && conv.ISO {
+		return "", errBriefAndISO
+	}
 	source := conv.Source
 	isNegativeDuration := false
 	if s, found := strings.CutPrefix(source, "-"); found {
@@ -487,7 +546,15 @@ func (conv *Conv) ConvertDuration() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if conv.ISO {
+		if err := checkISOTargetUnits(targetUnits); err != nil {
+			return "", err
+		}
+	}
 	result := conv.formatTarget(total, targetUnits)
+	if conv.ISO {
+		return formatISODuration(result)
+	}
 	if conv.Brief {
 		result = shrinkPeriod(result)
 	}
@@ -105,6 +105,8 @@ func TestConvInvalidInput(t *testing.T) {
 		{"90 minutes", "hours bananas"}, // unknown target unit (used to divide by zero)
 		{"90 minutes", "fortnights"},    // unknown single target unit
 		{"1 month", "days"},             // months are deliberately unsupported; lengths vary
+		{"2Q", "days"},                  // so are quarters, brief or long
+		{"90 days", "M"},                // as a target too
 		{"", "hours"},                   // empty source
 		{"abc days", "hours"},           // invalid numeric amount
 		{"15", "hours"},                 // bare number with no unit
@@ -117,6 +119,24 @@ func TestConvInvalidInput(t *testing.T) {
 	}
 }
 
+func TestConvVariableLengthUnitError(t *testing.T) {
+	t.Parallel()
+	cases := []struct{ source, target, want string }{
+		{"2B", "hours", "business days have no fixed length (business days skip weekends"},
+		{"3 businessdays", "hours", "business days have no fixed length"},
+		{"1 month", "days", "months have no fixed length (a month is 28 to 31 days long)"},
+		{"90 days", "M", "months have no fixed length"},
+		{"2Q", "days", "quarters have no fixed length (a quarter is 90 to 92 days long)"},
+		{"90 days", "quarters", "quarters have no fixed length"},
+	}
+	for _, c := range cases {
+		_, err := NewConv(ConvWithSource(c.source), ConvWithTarget(c.target)).ConvertDuration()
+		if err == nil || !strings.HasPrefix(err.Error(), c.want) || strings.Contains(err.Error(), "businessday\"") {
+			t.Errorf("source %q target %q: error = %v, want %q", c.source, c.target, err, c.want)
+		}
+	}
+}
+
 func TestConvEmptyTarget(t *testing.T) {
 	t.Parallel()
 	// an empty, whitespace-only, or bare-dot target used to panic with an
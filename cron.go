@@ -0,0 +1,165 @@
+// cron.go evaluates cron expressions: it lists the times a crontab entry
+// next (or last) fires on the wall clock of a time zone, and describes an
+// expression in plain English.
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/cron"
+)
+
+// DefaultCronCount is the number of run times returned when no count is
+// given
+const DefaultCronCount = 5
+
+// Cron lists the run times of Expression, a 5-field crontab expression,
+// a 6-field one with leading seconds, or a macro such as @daily. Runs are
+// strictly after From, or strictly before it when Previous is set, nearest
+// first; an empty From means now. Location is the time zone whose wall
+// clock the expression follows.
+type Cron struct {
+	Expression   string
+	From         string
+	Count        int
+	Previous     bool
+	Location     *time.Location
+	OutputFormat string
+	Locale       string
+	Parser       *Parser
+	Clock        Clock
+}
+
+type OptionsCron func(*Cron)
+
+// NewCron returns a Cron configured with the given options
+func NewCron(options ...OptionsCron) *Cron {
+	c := &Cron{}
+	for _, opt := range options {
+		opt(c)
+	}
+	return c
+}
+
+func CronWithExpression(expression string) OptionsCron {
+	return func(c *Cron) {
+		c.Expression = expression
+	}
+}
+
+func CronWithFrom(from string) OptionsCron {
+	return func(c *Cron) {
+		c.From = from
+	}
+}
+
+func CronWithCount(count int) OptionsCron {
+	return func(c *Cron) {
+		c.Count = count
+	}
+}
+
+// CronWithPrevious lists the runs before From instead of after it
+func CronWithPrevious(previous bool) OptionsCron {
+	return func(c *Cron) {
+		c.Previous = previous
+	}
+}
+
+// CronWithLocation sets the time zone whose wall clock the expression
+// follows; the default is the local time zone
+func CronWithLocation(loc *time.Location) OptionsCron {
+	return func(c *Cron) {
+		c.Location = loc
+	}
+}
+
+func CronWithOutputFormat(outputFormat string) OptionsCron {
+	return func(c *Cron) {
+		c.OutputFormat = outputFormat
+	}
+}
+
+func CronWithLocale(name string) OptionsCron {
+	return func(c *Cron) {
+		c.Locale = name
+	}
+}
+
+// CronWithParser parses From with the Parser's settings instead of the
+// environment's
+func CronWithParser(p *Parser) OptionsCron {
+	return func(c *Cron) {
+		c.Parser = p
+	}
+}
+
+// CronWithClock sets the reference instant that runs are listed from when
+// From is empty or relative, such as "now" or "tomorrow"
+func CronWithClock(clock Clock) OptionsCron {
+	return func(c *Cron) {
+		c.Clock = clock
+	}
+}
+
+func (c *Cron) String() string {
+	return fmt.Sprintf("Expression:%v From:%v Count:%v Previous:%v Location:%v OutputFormat:%v Locale:%v", c.Expression, c.From, c.Count, c.Previous, c.location(), c.OutputFormat, c.Locale)
+}
+
+// location returns the configured location, or time.Local when unset
+func (c *Cron) location() *time.Location {
+	if c.Location == nil {
+		return time.Local
+	}
+	return c.Location
+}
+
+// Times returns Count run times, or DefaultCronCount when Count is 0
+func (c *Cron) Times() ([]time.Time, error) {
+	if c.Count < 0 {
+		return nil, fmt.Errorf("count must not be negative: %d", c.Count)
+	}
+	if c.Count > maxUntilIterations {
+		return nil, fmt.Errorf("count must not exceed %d results: %d", maxUntilIterations, c.Count)
+	}
+	schedule, err := cron.Parse(c.Expression)
+	if err != nil {
+		return nil, err
+	}
+	loc := c.location()
+	p := c.Parser.withClock(c.Clock).orDefault()
+	from := p.now().In(loc)
+	if c.From != "" {
+		if from, err = p.parseIn(c.From, loc); err != nil {
+			return nil, err
+		}
+		from = from.In(loc)
+	}
+	count := c.Count
+	if count == 0 {
+		count = DefaultCronCount
+	}
+	return schedule.Times(from, count, c.Previous)
+}
+
+// Expand returns the run times rendered with OutputFormat, or with
+// time.Time.String when it is empty
+func (c *Cron) Expand() ([]string, error) {
+	all, err := c.Times()
+	if err != nil {
+		return nil, err
+	}
+	return renderTimes(all, c.OutputFormat, FormatWithLocale(c.Locale))
+}
+
+// Explain describes the expression in plain English, such as "Every 15
+// minutes, between 09:00 and 17:59, on Monday through Friday"
+func (c *Cron) Explain() (string, error) {
+	schedule, err := cron.Parse(c.Expression)
+	if err != nil {
+		return "", err
+	}
+	return schedule.Explain(), nil
+}
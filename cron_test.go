@@ -0,0 +1,72 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCron(t *testing.T) {
+	t.Parallel()
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	format := "%Y-%m-%d %a %H:%M %Z"
+	tests := []struct {
+		name    string
+		options []OptionsCron
+		want    []string
+	}{
+		{"next", []OptionsCron{CronWithExpression("*/15 9-17 * * MON-FRI"), CronWithFrom("2026-10-16 17:20"), CronWithCount(3)},
+			[]string{"2026-10-16 Fri 17:30 CEST", "2026-10-16 Fri 17:45 CEST", "2026-10-19 Mon 09:00 CEST"}},
+		{"previous", []OptionsCron{CronWithExpression("*/15 9-17 * * MON-FRI"), CronWithFrom("2026-10-19 09:00"), CronWithCount(2), CronWithPrevious(true)},
+			[]string{"2026-10-16 Fri 17:45 CEST", "2026-10-16 Fri 17:30 CEST"}},
+		{"default count", []OptionsCron{CronWithExpression("@daily"), CronWithFrom("2026-10-24")},
+			[]string{"2026-10-25 Sun 00:00 CEST", "2026-10-26 Mon 00:00 CET", "2026-10-27 Tue 00:00 CET", "2026-10-28 Wed 00:00 CET", "2026-10-29 Thu 00:00 CET"}},
+		{"from with a zone", []OptionsCron{CronWithExpression("0 9 * * *"), CronWithFrom("2026-10-18T08:30:00Z"), CronWithCount(1)},
+			[]string{"2026-10-19 Mon 09:00 CEST"}},
+	}
+	for _, tt := range tests {
+		options := append([]OptionsCron{CronWithLocation(berlin), CronWithOutputFormat(format)}, tt.options...)
+		got, err := NewCron(options...).Expand()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s:\n got %v\nwant %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCronExplain(t *testing.T) {
+	t.Parallel()
+	got, err := NewCron(CronWithExpression("*/15 9-17 * * MON-FRI")).Explain()
+	if want := "Every 15 minutes, between 09:00 and 17:59, on Monday through Friday"; err != nil || got != want {
+		t.Errorf("Explain = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestCronInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		options []OptionsCron
+		want    string
+	}{
+		{[]OptionsCron{CronWithExpression("* * *")}, "expected 5 fields"},
+		{[]OptionsCron{CronWithExpression("@daily"), CronWithCount(-1)}, "negative"},
+		{[]OptionsCron{CronWithExpression("@daily"), CronWithFrom("someday")}, "someday"},
+		{[]OptionsCron{CronWithExpression("0 0 30 2 *"), CronWithFrom("2026-10-18")}, "never fires"},
+	}
+	for _, tt := range tests {
+		_, err := NewCron(tt.options...).Expand()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: error = %v, want it to mention %q", NewCron(tt.options...), err, tt.want)
+		}
+	}
+	if _, err := NewCron(CronWithExpression("@reboot")).Explain(); err == nil {
+		t.Error("Explain(@reboot): expected an error")
+	}
+}
@@ -0,0 +1,90 @@
+// datemath.go evaluates Grafana-style date math expressions such as
+// "now-7d/d": an anchor date followed by offsets and snaps to the start or
+// end of a unit
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+)
+
+var (
+	// dateMathOpsRegexp matches the trailing operations of an expression:
+	// offsets such as "-7d" or "+1M" in brief units (lowercase d, w, and y
+	// are accepted too, as Grafana writes them), and snaps such as "/d" to
+	// the start or "^M" to the end of a unit
+	dateMathOpsRegexp = regexp.MustCompile(`(?:[+-]\d+(?:ms|us|ns|[YyQMWwDdhms])|[/^][YyQMWwDdhm])+$`)
+	dateMathOpRegexp  = regexp.MustCompile(`^(?:([+-])(\d+)(ms|us|ns|[YyQMWwDdhms])|([/^])([YyQMWwDdhm]))`)
+)
+
+// dateMathUnits maps the brief units of an expression to datecalc units
+var dateMathUnits = map[string]string{
+	"Y": "year", "y": "year", "Q": "quarter", "M": "month", "W": "week", "w": "week", "D": "day", "d": "day",
+	"h": "hour", "m": "minute", "s": "second", "ms": "millisecond", "us": "microsecond", "ns": "nanosecond",
+}
+
+// parseDateMath evaluates a date math expression, such as "now-7d/d",
+// "now/w", or "2026-03-08 14:00+1M^M": the anchor, "now" or any date/time
+// parseDateTimeOrUnixIn accepts, then offsets and snaps applied left to
+// right. Offsets move by whole brief units, days and longer on the
+// calendar; "/unit" snaps to the start of the minute, hour, day, week
+// (from Monday), month, quarter, or year and "^unit" to its last
+// nanosecond, both in the anchor's zone. claimed is false when source has
+// no trailing operations, so the caller can try other parsers; an input
+// starting with "now" and an operator is always claimed.
+func parseDateMath(source string, loc *time.Location) (t time.Time, claimed bool, err error) {
+	t, _, claimed, err = defaultParser.detectDateMath(source, loc)
+	return t, claimed, err
+}
+
+// detectDateMath is parseDateMath with the Parser's settings, also
+// reporting how the anchor was read
+func (p *Parser) detectDateMath(source string, loc *time.Location) (t time.Time, anchor Detection, claimed bool, err error) {
+	var anchorSource, ops string
+	if len(source) > 3 && strings.EqualFold(source[:3], "now") && strings.ContainsRune("+-/^", rune(source[3])) {
+		anchorSource, ops = source[:3], source[3:]
+	} else if span := dateMathOpsRegexp.FindStringIndex(source); span != nil && span[0] > 0 {
+		anchorSource, ops = strings.TrimSpace(source[:span[0]]), source[span[0]:]
+	} else {
+		return time.Time{}, anchor, false, nil
+	}
+	t, anchor, err = p.detectDateTimeOrUnixIn(anchorSource, loc)
+	if err != nil {
+		return time.Time{}, anchor, true, fmt.Errorf("invalid date math expression %q: %w", source, err)
+	}
+	for ops != "" {
+		m := dateMathOpRegexp.FindStringSubmatch(ops)
+		if m == nil {
+			return time.Time{}, anchor, true, fmt.Errorf("invalid date math expression %q: %q is not an offset such as -7d or a snap such as /d or ^d", source, ops)
+		}
+		ops = ops[len(m[0]):]
+		if m[4] != "" {
+			unit := dateMathUnits[m[5]]
+			if m[4] == "/" {
+				t, err = datecalc.StartOf(t, unit)
+			} else {
+				t, err = datecalc.EndOf(t, unit)
+			}
+		} else {
+			n, convErr := strconv.Atoi(m[2])
+			if convErr != nil {
+				return time.Time{}, anchor, true, fmt.Errorf("invalid date math expression %q: %w", source, convErr)
+			}
+			sign := 1
+			if m[1] == "-" {
+				sign = -1
+			}
+			t, err = datecalc.Apply(t, dateMathUnits[m[3]], n, sign)
+		}
+		if err != nil {
+			return time.Time{}, anchor, true, fmt.Errorf("invalid date math expression %q: %w", source, err)
+		}
+	}
+	return t, anchor, true, nil
+}
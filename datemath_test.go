@@ -0,0 +1,103 @@
+package DateTimeMate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDateMath(t *testing.T) {
+	t.Parallel()
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"2026-03-08 14:00/d":          "2026-03-08 00:00:00 EST",
+		"2026-03-08 14:00^d":          "2026-03-08 23:59:59.999999999 EDT",
+		"2026-03-08 14:00-1d":         "2026-03-07 14:00:00 EST",
+		"2026-03-09 14:00-1d/d":       "2026-03-08 00:00:00 EST",
+		"2026-03-09 14:00-7D/w":       "2026-03-02 00:00:00 EST",
+		"2026-03-09 14:00^W":          "2026-03-15 23:59:59.999999999 EDT",
+		"2026-01-31-1M/M":             "2025-12-01 00:00:00 EST",
+		"2026-10-18 14:30+1Q/Q":       "2027-01-01 00:00:00 EST",
+		"2026-10-18 14:30^Q":          "2026-12-31 23:59:59.999999999 EST",
+		"2026-10-18 14:30/y":          "2026-01-01 00:00:00 EST",
+		"2026-10-18 14:30-1y^Y":       "2025-12-31 23:59:59.999999999 EST",
+		"2026-10-18 14:30:45/m":       "2026-10-18 14:30:00 EDT",
+		"2026-10-18 14:30-1d-12h+30m": "2026-10-17 03:00:00 EDT",
+		"2026-10-18 14:30+90s+500ms":  "2026-10-18 14:31:30.5 EDT",
+		"2026-11-01T05:30:00Z/h":      "2026-11-01 05:00:00 UTC",
+		"2026-03-08T12:00:00+05:30/d": "2026-03-08 00:00:00 +0530",
+		"2026-W07-3+1d":               "2026-02-12 00:00:00 EST",
+	}
+	for source, want := range tests {
+		got, claimed, err := parseDateMath(source, ny)
+		if !claimed || err != nil {
+			t.Errorf("parseDateMath(%q) = %v, %v; want %s", source, claimed, err, want)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05.999999999 MST"); s != want {
+			t.Errorf("parseDateMath(%q) = %s, want %s", source, s, want)
+		}
+	}
+}
+
+// TestParseDateMathRepeatedHour snaps each copy of the hour repeated when
+// New York's clocks fall back to its own start
+func TestParseDateMathRepeatedHour(t *testing.T) {
+	t.Parallel()
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for source, want := range map[string]string{
+		"2026-11-01T05:45:00Z/h": "2026-11-01 01:00:00 EDT",
+		"2026-11-01T06:45:00Z/h": "2026-11-01 01:00:00 EST",
+	} {
+		got, _, err := parseDateMath(source, ny)
+		if err != nil || got.In(ny).Format("2006-01-02 15:04:05 MST") != want {
+			t.Errorf("parseDateMath(%q) = %s, %v; want %s", source, got.In(ny), err, want)
+		}
+	}
+}
+
+func TestParseDateMathNow(t *testing.T) {
+	t.Parallel()
+	got, err := parseDateTimeOrUnix("now-7d/d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := parseDateTimeOrUnix("7 days ago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = time.Date(want.Year(), want.Month(), want.Day(), 0, 0, 0, 0, time.Local)
+	if !got.Equal(want) {
+		t.Errorf("now-7d/d = %v, want %v", got, want)
+	}
+	if got, err := parseDateTimeOrUnix("NOW^M"); err != nil || got.Add(time.Nanosecond).Day() != 1 {
+		t.Errorf("NOW^M = %v, %v; want the last nanosecond of the month", got, err)
+	}
+}
+
+func TestParseDateMathInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"now/x":            `"/x" is not an offset`,
+		"now-7":            `"-7" is not an offset`,
+		"now+1d/s":         `"/s" is not an offset`,
+		"now-99999999999h": "exceeds the supported range",
+		"2026-02-30/d":     "invalid date math expression",
+	}
+	for source, want := range tests {
+		if _, claimed, err := parseDateMath(source, time.UTC); !claimed || err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("parseDateMath(%q) = %v, %v; want a claimed error mentioning %q", source, claimed, err, want)
+		}
+	}
+	for _, source := range []string{"now", "2026-03-08", "2026-03-08T12:00:00-05:00", "2024/01/02", "-1d", "2026-W07-3"} {
+		if _, claimed, err := parseDateMath(source, time.UTC); claimed {
+			t.Errorf("parseDateMath(%q) claimed it, err = %v", source, err)
+		}
+	}
+}
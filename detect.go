@@ -0,0 +1,118 @@
+// detect.go reports how a date/time input was read: the parsing layer and
+// layout that matched, the kind of result, where its zone came from, how an
+// ambiguous slash date was ordered, and the strftime format and Go layout
+// that render a time in the same shape
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/dtparse"
+)
+
+// parsing layers reported by DetectFormat, in the order they are tried
+const (
+	LayerDateMath       = "date math"
+	LayerUnixTimestamp  = "unix timestamp"
+	LayerCompactInteger = "compact integer"
+	LayerRelativeWord   = "relative word"
+	LayerISOWeekDate    = "ISO 8601 week date"
+	LayerISOOrdinalDate = "ISO 8601 ordinal date"
+	LayerRelativeDate   = "relative date"
+	LayerWallClock      = "wall-clock layout"
+	LayerZoned          = "zoned layout"
+	LayerSlashDate      = "slash date"
+	LayerFallback       = "dtparse layout"
+)
+
+// kinds of parsed input reported by DetectFormat: a wall clock read in the
+// local time zone, a time of day stamped with today's date, a date/time
+// carrying its own zone or offset, or an instant such as a unix timestamp
+const (
+	KindWallClock = "wall-clock"
+	KindTimeOnly  = "time-only"
+	KindZoned     = "zoned"
+	KindInstant   = "instant"
+)
+
+// Detection describes how DetectFormat or a Parser read an input. Layout is
+// the Go layout that matched, when the layer uses one; DateOrder is set only
+// for slash dates, and AnchorLayer only for date math. Strftime and GoLayout
+// render a time in the input's shape, and are empty when there is no
+// equivalent, as for relative dates or a Go layout's "Z07:00". Warning holds
+// an ambiguity resolved by default, such as a slash date read month first.
+type Detection struct {
+	Source      string
+	Time        time.Time
+	Layer       string
+	AnchorLayer string
+	Layout      string
+	Kind        string
+	Zone        string
+	DateOrder   string
+	Strftime    string
+	GoLayout    string
+	Warning     string
+}
+
+func (d Detection) String() string {
+	return fmt.Sprintf("Source:%v Time:%v Layer:%v AnchorLayer:%v Layout:%v Kind:%v Zone:%v DateOrder:%v Strftime:%v GoLayout:%v Warning:%v",
+		d.Source, d.Time, d.Layer, d.AnchorLayer, d.Layout, d.Kind, d.Zone, d.DateOrder, d.Strftime, d.GoLayout, d.Warning)
+}
+
+// detectionKinds maps the kinds of dtparse layouts to the kinds reported
+var detectionKinds = map[dtparse.Kind]string{
+	dtparse.KindWallClock: KindWallClock,
+	dtparse.KindTimeOnly:  KindTimeOnly,
+	dtparse.KindZoned:     KindZoned,
+}
+
+// DetectFormat parses source as every command does, zone-less input in the
+// local time zone or in that of FormatWithInputZone, with the settings of
+// FormatWithParser and FormatWithClock, and reports which layer, layout,
+// and zone read it
+func DetectFormat(source string, options ...OptionsFormat) (Detection, error) {
+	var settings formatSettings
+	for _, opt := range options {
+		opt(&settings)
+	}
+	p, err := settings.parser.withClock(settings.clock).orDefault().inZone(settings.inputZone)
+	if err != nil {
+		return Detection{}, err
+	}
+	_, d, err := p.Parse(source)
+	return d, err
+}
+
+// describe fills in the source, the time, where its zone came from, and
+// the layout and strftime format rendering times in the source's shape;
+// zone-less input and instants are in loc
+func (d *Detection) describe(source string, t time.Time, loc *time.Location) {
+	d.Source, d.Time = source, t
+	name, offset := t.Zone()
+	where := "local time"
+	if loc != time.Local {
+		where = loc.String()
+	}
+	switch d.Kind {
+	case KindZoned:
+		if name != "" && strings.Contains(strings.ToUpper(source), strings.ToUpper(name)) {
+			d.Zone = fmt.Sprintf("the input's abbreviation %s (UTC%s)", name, FormatUTCOffset(offset))
+		} else {
+			d.Zone = fmt.Sprintf("the input's offset (UTC%s)", FormatUTCOffset(offset))
+		}
+	case KindInstant:
+		d.Zone = fmt.Sprintf("none needed, an instant: shown in %s (%s, UTC%s)", where, name, FormatUTCOffset(offset))
+	default:
+		d.Zone = fmt.Sprintf("none in the input: %s (%s, UTC%s)", where, name, FormatUTCOffset(offset))
+	}
+	if d.Layout != "" {
+		d.GoLayout = d.Layout
+		if d.Strftime == "" {
+			d.Strftime, _ = LayoutToStrftime(d.Layout)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package DateTimeMate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectFormat(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	t.Setenv(DateOrderEnvVar, "DMY")
+	tests := []struct {
+		source    string
+		layer     string
+		layout    string
+		kind      string
+		dateOrder string
+		strftime  string
+	}{
+		{"2024-01-15 08:30:00", LayerWallClock, "2006-01-02 15:04:05", KindWallClock, "", "%Y-%m-%d %H:%M:%S"},
+		{"Wed, 22 Jul 2026 15:04:05 -0400", LayerZoned, time.RFC1123Z, KindZoned, "", "%a, %d %b %Y %H:%M:%S %z"},
+		{"2024-01-15T12:00:00Z", LayerZoned, time.RFC3339Nano, KindZoned, "", ""},
+		{"01/02/2024", LayerSlashDate, "2/1/2006", KindWallClock, "day/month/year: ambiguous, set by DTMATE_DATE_ORDER=DMY", "%-d/%-m/%Y"},
+		{"12/25/2024 3:04 PM", LayerSlashDate, "1/2/2006 3:04 PM", KindWallClock, "month/day/year: 25 cannot be a month", "%-m/%-d/%Y %-I:%M %p"},
+		{"Jan 2, 2024 08:30", LayerFallback, "Jan 2, 2006 15:4", KindWallClock, "", "%b %-d, %Y %H:%-M"},
+		{"8:30pm", LayerFallback, "3:4pm", KindTimeOnly, "", ""},
+		{"1704085262", LayerUnixTimestamp, "", KindInstant, "", "%s"},
+		{"1704085262999", LayerUnixTimestamp, "", KindInstant, "", "%3s"},
+		{"20240101080102", LayerCompactInteger, "20060102150405", KindWallClock, "", "%Y%m%d%H%M%S"},
+		{"tomorrow", LayerRelativeWord, "", KindInstant, "", ""},
+		{"next friday 9am", LayerRelativeDate, "", KindWallClock, "", ""},
+		{"2026-W07-3", LayerISOWeekDate, "", KindWallClock, "", "%G-W%V-%u"},
+		{"2026W07", LayerISOWeekDate, "", KindWallClock, "", "%GW%V"},
+		{"2026-045", LayerISOOrdinalDate, "2006-002", KindWallClock, "", "%Y-%j"},
+	}
+	for _, tt := range tests {
+		d, err := DetectFormat(tt.source)
+		if err != nil {
+			t.Errorf("DetectFormat(%q): %v", tt.source, err)
+			continue
+		}
+		if d.Layer != tt.layer || d.Layout != tt.layout || d.Kind != tt.kind || d.DateOrder != tt.dateOrder || d.Strftime != tt.strftime {
+			t.Errorf("DetectFormat(%q) = %v", tt.source, d)
+		}
+		if d.GoLayout != tt.layout {
+			t.Errorf("DetectFormat(%q).GoLayout = %q, want %q", tt.source, d.GoLayout, tt.layout)
+		}
+	}
+}
+
+func TestDetectFormatZoneAndDateMath(t *testing.T) {
+	t.Parallel()
+	d, err := DetectFormat("2024-07-22 08:21:44 UTC")
+	if err != nil || !strings.Contains(d.Zone, "abbreviation UTC (UTC+00:00)") {
+		t.Errorf("DetectFormat zone = %q, %v", d.Zone, err)
+	}
+	d, err = DetectFormat("2024-07-22T08:21:44+05:30")
+	if err != nil || !strings.Contains(d.Zone, "offset (UTC+05:30)") {
+		t.Errorf("DetectFormat zone = %q, %v", d.Zone, err)
+	}
+	d, err = DetectFormat("2024-07-22 08:21:44")
+	if err != nil || !strings.HasPrefix(d.Zone, "none in the input") {
+		t.Errorf("DetectFormat zone = %q, %v", d.Zone, err)
+	}
+	d, err = DetectFormat("2024-07-22 08:21:44", FormatWithInputZone("Asia/Tokyo"))
+	if err != nil || !strings.Contains(d.Zone, "Asia/Tokyo") || d.Time.Location().String() != "Asia/Tokyo" {
+		t.Errorf("DetectFormat with an input zone = %q %v, %v", d.Zone, d.Time, err)
+	}
+	if _, err := DetectFormat("2024-07-22 08:21:44", FormatWithInputZone("Nowhere/Else")); err == nil || !strings.Contains(err.Error(), "input zone") {
+		t.Errorf("DetectFormat with an unknown input zone: err = %v", err)
+	}
+	d, err = DetectFormat("2026-03-08 14:00+1M^M")
+	if err != nil || d.Layer != LayerDateMath || d.AnchorLayer != LayerWallClock || d.Layout != "" {
+		t.Errorf("DetectFormat(date math) = %v, %v", d, err)
+	}
+	if _, err := DetectFormat("2024-02-30"); err == nil {
+		t.Error("DetectFormat(2024-02-30): expected an error, got nil")
+	}
+}
@@ -2,15 +2,22 @@ package DateTimeMate
 
 import (
 	"fmt"
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
 	"github.com/jftuga/DateTimeMate/internal/humandur"
+	"strings"
 	"time"
 )
 
 type Diff struct {
-	Start    string
-	End      string
-	Brief    bool
-	Absolute bool
+	Start     string
+	End       string
+	Brief     bool
+	ISO       bool
+	Absolute  bool
+	Schedule  *BusinessSchedule
+	Parser    *Parser
+	Clock     Clock
+	InputZone string
 }
 
 type OptionsDiff func(*Diff)
@@ -41,6 +48,15 @@ func DiffWithBrief(brief bool) OptionsDiff {
 	}
 }
 
+// DiffWithISO renders differences as ISO 8601 durations: CalculateDiff
+// breaks the duration down from days, as "P1DT12H", and
+// CalculateCalendarDiff keeps its calendar fields, as "P4Y1M2D"
+func DiffWithISO(iso bool) OptionsDiff {
+	return func(opt *Diff) {
+		opt.ISO = iso
+	}
+}
+
 // DiffWithAbsolute makes CalculateDiff return an absolute (positive)
 // duration and formatted string regardless of argument order
 func DiffWithAbsolute(absolute bool) OptionsDiff {
@@ -49,20 +65,74 @@ func DiffWithAbsolute(absolute bool) OptionsDiff {
 	}
 }
 
+// DiffWithBusinessSchedule makes CalculateDiff count only the working time
+// of the schedule, rendered in hours, minutes, and seconds
+func DiffWithBusinessSchedule(schedule *BusinessSchedule) OptionsDiff {
+	return func(opt *Diff) {
+		opt.Schedule = schedule
+	}
+}
+
+// DiffWithParser parses Start and End with the Parser's settings instead of
+// the environment's
+func DiffWithParser(p *Parser) OptionsDiff {
+	return func(opt *Diff) {
+		opt.Parser = p
+	}
+}
+
+// DiffWithClock sets the reference instant for relative Start and End
+// values such as "now" or "3 days ago"
+func DiffWithClock(c Clock) OptionsDiff {
+	return func(opt *Diff) {
+		opt.Clock = c
+	}
+}
+
+// DiffWithInputZone reads a Start or End without a zone of its own as a wall
+// clock in zone: an IANA name, an abbreviation, an alias from
+// ZoneAliasesEnvVar, or a UTC offset, resolved as by ResolveZone
+func DiffWithInputZone(zone string) OptionsDiff {
+	return func(opt *Diff) {
+		opt.InputZone = zone
+	}
+}
+
+// parser returns the Parser reading Start and End
+func (diff *Diff) parser() (*Parser, error) {
+	return diff.Parser.withClock(diff.Clock).inZone(diff.InputZone)
+}
+
 func (diff *Diff) String() string {
-	return fmt.Sprintf("Start:%v End:%v Brief:%v Absolute:%v", diff.Start, diff.End, diff.Brief, diff.Absolute)
+	return fmt.Sprintf("Start:%v End:%v Brief:%v ISO:%v Absolute:%v InputZone:%v", diff.Start, diff.End, diff.Brief, diff.ISO, diff.Absolute, diff.InputZone)
+}
+
+// Endpoints returns Start and End as parsed by CalculateDiff; convert them
+// with TimeZoneConverter.ConvertTime to show them in another zone
+func (diff *Diff) Endpoints() (start, end time.Time, err error) {
+	p, err := diff.parser()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if start, err = p.parse(diff.Start); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = p.parse(diff.End); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
 }
 
 // CalculateDiff returns the time difference between Start and End, both as
 // a formatted string and as a time.Duration; both sides are parsed with the
-// same shared chain used by every other sub-command (parseDateTimeOrUnix)
-// when Absolute is set, both the formatted string and the returned duration are non-negative
+// same shared chain used by every other sub-command, or by the Parser when set
+// when Absolute is set, both the formatted string and the returned duration are non-negative;
+// with a Schedule, only its working time is counted
 func (diff *Diff) CalculateDiff() (string, time.Duration, error) {
-	start, err := parseDateTimeOrUnix(diff.Start)
-	if err != nil {
-		return "", 0, err
+	if diff.Brief && diff.ISO {
+		return "", 0, errBriefAndISO
 	}
-	end, err := parseDateTimeOrUnix(diff.End)
+	start, end, err := diff.Endpoints()
 	if err != nil {
 		return "", 0, err
 	}
@@ -75,12 +145,144 @@ func (diff *Diff) CalculateDiff() (string, time.Duration, error) {
 	if !start.Add(duration).Equal(end) {
 		return "", 0, fmt.Errorf("difference between %q and %q exceeds the representable range of about 292 years", diff.Start, diff.End)
 	}
+	if diff.Schedule != nil {
+		duration = diff.Schedule.Elapsed(start, end)
+	}
 	if diff.Absolute {
 		duration = duration.Abs()
 	}
 	difference := humandur.Format(duration)
+	if diff.Schedule != nil {
+		difference = formatBusinessDuration(duration)
+	} else if diff.ISO {
+		difference = (&Conv{}).formatTarget(int64(duration), isoDefaultUnits)
+	}
+	if diff.ISO {
+		difference, err = formatISODuration(difference)
+		if err != nil {
+			return "", 0, err
+		}
+	}
 	if diff.Brief {
 		difference = shrinkPeriod(difference)
 	}
 	return difference, duration, nil
 }
+
+// CalendarDiff is a difference counted on the calendar the way people
+// count it: whole years, months, and days between the two wall clocks,
+// plus the time-of-day remainder; 2020-02-29 to 2024-02-29 is exactly
+// 4 years. WallClock is the span between the two wall clocks, ignoring
+// DST, while Elapsed is the real time that passed; they differ when the
+// span crosses a DST change. Every field is non-negative and Negative
+// records that End precedes Start.
+type CalendarDiff struct {
+	Negative  bool
+	Years     int
+	Months    int
+	Days      int
+	Clock     time.Duration
+	WallClock time.Duration
+	Elapsed   time.Duration
+}
+
+// String renders the calendar fields largest first, omitting zero-valued
+// components, e.g. "1 year 2 months 3 days 4 hours 5 minutes"; the
+// time-of-day remainder is rendered like CalculateDiff's output
+func (cd CalendarDiff) String() string {
+	var parts []string
+	for _, field := range []struct {
+		count int
+		name  string
+	}{{cd.Years, "year"}, {cd.Months, "month"}, {cd.Days, "day"}} {
+		if field.count == 0 {
+			continue
+		}
+		name := field.name
+		if field.count != 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", field.count, name))
+	}
+	if cd.Clock != 0 || len(parts) == 0 {
+		parts = append(parts, humandur.Format(cd.Clock))
+	}
+	result := strings.Join(parts, " ")
+	if cd.Negative && (cd.Years != 0 || cd.Months != 0 || cd.Days != 0 || cd.Clock != 0) {
+		result = "-" + result
+	}
+	return result
+}
+
+// CrossesDST reports whether a DST change makes the wall-clock span differ
+// from the elapsed time
+func (cd CalendarDiff) CrossesDST() bool {
+	return cd.WallClock != cd.Elapsed
+}
+
+// CalculateCalendarDiff returns the calendar difference between Start and
+// End, both as a formatted string and as a CalendarDiff. The wall clocks are
+// compared in Start's location; months are counted with the end-of-month
+// clamp, so Jan 31 to Feb 29 is 1 month. Brief, ISO, and Absolute apply as
+// they do for CalculateDiff.
+func (diff *Diff) CalculateCalendarDiff() (string, CalendarDiff, error) {
+	if diff.Brief && diff.ISO {
+		return "", CalendarDiff{}, errBriefAndISO
+	}
+	start, end, err := diff.Endpoints()
+	if err != nil {
+		return "", CalendarDiff{}, err
+	}
+	elapsed := end.Sub(start)
+	if !start.Add(elapsed).Equal(end) {
+		return "", CalendarDiff{}, fmt.Errorf("difference between %q and %q exceeds the representable range of about 292 years", diff.Start, diff.End)
+	}
+
+	cd := calendarDiff(start, end.In(start.Location()))
+	if diff.Absolute {
+		cd.Negative = false
+	}
+	difference := cd.String()
+	if diff.ISO {
+		difference, err = formatISODuration(difference)
+		if err != nil {
+			return "", CalendarDiff{}, err
+		}
+	}
+	if diff.Brief {
+		difference = shrinkPeriod(difference)
+	}
+	return difference, cd, nil
+}
+
+// calendarDiff counts the calendar fields between the wall clocks of start
+// and end, which must share a location; the fields are computed on UTC
+// copies of the wall clocks so a DST change cannot shorten or lengthen a day
+func calendarDiff(start, end time.Time) CalendarDiff {
+	cd := CalendarDiff{Elapsed: end.Sub(start)}
+	from, to := wallClockUTC(start), wallClockUTC(end)
+	if to.Before(from) {
+		cd.Negative = true
+		from, to = to, from
+		cd.Elapsed = -cd.Elapsed
+	}
+	cd.WallClock = to.Sub(from)
+
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	anchor := datecalc.AddMonths(from, months, datecalc.Clamp)
+	if anchor.After(to) {
+		months--
+		anchor = datecalc.AddMonths(from, months, datecalc.Clamp)
+	}
+	cd.Years, cd.Months = months/12, months%12
+	remainder := to.Sub(anchor)
+	cd.Days = int(remainder / (24 * time.Hour))
+	cd.Clock = remainder % (24 * time.Hour)
+	return cd
+}
+
+// wallClockUTC returns t's wall clock reinterpreted in UTC, which has no
+// DST, so subtracting two such values measures wall-clock distance
+func wallClockUTC(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
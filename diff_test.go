@@ -1,6 +1,10 @@
 package DateTimeMate
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func testDiffStartEnd(t *testing.T, start, end string, brief bool, correct string) {
 	t.Helper()
@@ -220,3 +224,112 @@ func TestDiffRelativeStartEnd(t *testing.T) {
 	correct = "1 day"
 	testDiffStartEnd(t, start, end, false, correct)
 }
+
+func testCalendarDiff(t *testing.T, start, end string, brief, absolute bool, correct string) CalendarDiff {
+	t.Helper()
+	diff := NewDiff(
+		DiffWithStart(start),
+		DiffWithEnd(end),
+		DiffWithBrief(brief),
+		DiffWithAbsolute(absolute))
+	result, cd, err := diff.CalculateCalendarDiff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != correct {
+		t.Errorf("[start: %v] [end: %v] [computed: %v] != [correct: %v]", start, end, result, correct)
+	}
+	return cd
+}
+
+func TestCalendarDiff(t *testing.T) {
+	t.Parallel()
+	// leap day to leap day is exactly 4 years, not "4 years 1 day"
+	testCalendarDiff(t, "2020-02-29", "2024-02-29", false, false, "4 years")
+	testCalendarDiff(t, "2020-02-29", "2024-02-29", true, false, "4Y")
+	testCalendarDiff(t, "2024-01-15 08:00:00", "2025-03-17 10:30:15", false, false, "1 year 2 months 2 days 2 hours 30 minutes 15 seconds")
+	testCalendarDiff(t, "2024-01-15 08:00:00", "2025-03-17 10:30:15", true, false, "1Y2M2D2h30m15s")
+	// months are counted with the end-of-month clamp
+	testCalendarDiff(t, "2024-01-31", "2024-02-29", false, false, "1 month")
+	testCalendarDiff(t, "2024-01-31", "2024-03-01", false, false, "1 month 1 day")
+	testCalendarDiff(t, "2024-01-31", "2024-03-31", false, false, "2 months")
+	// a time of day earlier than the start's borrows a day
+	testCalendarDiff(t, "2024-01-15 18:00:00", "2024-02-15 06:00:00", false, false, "30 days 12 hours")
+	testCalendarDiff(t, "2024-06-01", "2024-06-01", false, false, "0 seconds")
+	testCalendarDiff(t, "1950-06-15", "2024-06-15", false, false, "74 years")
+}
+
+func TestCalendarDiffSigned(t *testing.T) {
+	t.Parallel()
+	cd := testCalendarDiff(t, "2024-03-15", "2024-01-10", false, false, "-2 months 5 days")
+	if !cd.Negative || cd.Elapsed <= 0 {
+		t.Errorf("expected a negative calendar diff with a positive elapsed time, got: %v (elapsed %v)", cd, cd.Elapsed)
+	}
+	testCalendarDiff(t, "2024-03-15", "2024-01-10", true, false, "-2M5D")
+	testCalendarDiff(t, "2024-03-15", "2024-01-10", false, true, "2 months 5 days")
+}
+
+func TestCalendarDiffAcrossDST(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// New York springs forward on 2024-03-10: one calendar day, but only
+	// 23 hours elapse
+	cd := calendarDiff(time.Date(2024, 3, 9, 12, 0, 0, 0, loc), time.Date(2024, 3, 10, 12, 0, 0, 0, loc))
+	if cd.String() != "1 day" || !cd.CrossesDST() || cd.WallClock != 24*time.Hour || cd.Elapsed != 23*time.Hour {
+		t.Errorf("expected 1 day with a 24 hour wall clock and 23 hours elapsed, got: %v (wall clock %v, elapsed %v)", cd, cd.WallClock, cd.Elapsed)
+	}
+	// falling back on 2024-11-03, noon to noon is 25 hours
+	cd = calendarDiff(time.Date(2024, 11, 2, 12, 0, 0, 0, loc), time.Date(2024, 11, 3, 12, 0, 0, 0, loc))
+	if cd.String() != "1 day" || cd.Elapsed != 25*time.Hour {
+		t.Errorf("expected 1 day with 25 hours elapsed, got: %v (elapsed %v)", cd, cd.Elapsed)
+	}
+	// spring and fall changes cancel out
+	cd = calendarDiff(time.Date(2024, 3, 9, 12, 0, 0, 0, loc), time.Date(2024, 11, 10, 12, 0, 0, 0, loc))
+	if cd.String() != "8 months 1 day" || cd.CrossesDST() {
+		t.Errorf("expected 8 months 1 day without a DST shift, got: %v (wall clock %v, elapsed %v)", cd, cd.WallClock, cd.Elapsed)
+	}
+}
+
+func TestDiffWithInputZone(t *testing.T) {
+	t.Setenv(ZoneAliasesEnvVar, "HQ=America/Chicago")
+	// Chicago springs forward at 02:00 on 2026-03-08, so three wall-clock
+	// hours are two elapsed hours there, whatever the local time zone
+	for _, zone := range []string{"America/Chicago", "hq"} {
+		diff := NewDiff(DiffWithStart("2026-03-08 01:00"), DiffWithEnd("2026-03-08 04:00"), DiffWithInputZone(zone))
+		if got, _, err := diff.CalculateDiff(); err != nil || got != "2 hours" {
+			t.Errorf("CalculateDiff in %s = %q, %v, want 2 hours", zone, got, err)
+		}
+	}
+	diff := NewDiff(DiffWithStart("2026-03-08 01:00"), DiffWithEnd("2026-03-08 04:00"), DiffWithInputZone("UTC"))
+	if got, _, err := diff.CalculateDiff(); err != nil || got != "3 hours" {
+		t.Errorf("CalculateDiff in UTC = %q, %v, want 3 hours", got, err)
+	}
+	// an input with its own offset keeps it
+	diff = NewDiff(DiffWithStart("2026-03-08T01:00:00Z"), DiffWithEnd("2026-03-08 16:00"), DiffWithInputZone("Asia/Tokyo"))
+	if got, _, err := diff.CalculateDiff(); err != nil || got != "6 hours" {
+		t.Errorf("CalculateDiff of a zoned start = %q, %v, want 6 hours", got, err)
+	}
+	diff = NewDiff(DiffWithStart("2026-03-08"), DiffWithEnd("2026-03-09"), DiffWithInputZone("Nowhere/Zone"))
+	if _, _, err := diff.CalculateCalendarDiff(); err == nil || !strings.Contains(err.Error(), "input zone") {
+		t.Errorf("CalculateCalendarDiff in an unknown zone: err = %v, want an input zone error", err)
+	}
+}
+
+func TestDiffEndpoints(t *testing.T) {
+	t.Parallel()
+	diff := NewDiff(DiffWithStart("2026-03-08 01:00"), DiffWithEnd("2026-03-08T10:00:00Z"), DiffWithInputZone("America/Chicago"))
+	start, end, err := diff.Endpoints()
+	if err != nil {
+		t.Fatalf("Endpoints: %v", err)
+	}
+	if want := time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC); !start.Equal(want) || !end.Equal(want.Add(3*time.Hour)) {
+		t.Errorf("Endpoints = %v, %v", start, end)
+	}
+	tokyo, err := NewTimeZoneConverter().ConvertTime(start, "Asia/Tokyo")
+	if err != nil || tokyo.Format("2006-01-02 15:04 MST") != "2026-03-08 16:00 JST" {
+		t.Errorf("ConvertTime(start) = %v, %v", tokyo, err)
+	}
+}
@@ -3,7 +3,6 @@ package DateTimeMate
 import (
 	"fmt"
 	"github.com/jftuga/DateTimeMate/internal/datecalc"
-	"github.com/lestrrat-go/strftime"
 	"math"
 	"regexp"
 	"strconv"
@@ -23,6 +22,51 @@ type Dur struct {
 	Repeat       int
 	Until        string
 	OutputFormat string
+	Layout       string
+	Locale       string
+	EndOfMonth   EndOfMonthPolicy
+	Weekend      []time.Weekday
+	Holidays     HolidayChecker
+	Schedule     *BusinessSchedule
+	Parser       *Parser
+	Clock        Clock
+	InputZone    string
+	OutputZone   string
+	Converter    *TimeZoneConverter
+}
+
+// EndOfMonthPolicy selects how year, quarter, and month arithmetic treats
+// a day of the month that does not exist in the target month
+type EndOfMonthPolicy int
+
+const (
+	// EndOfMonthOverflow normalizes the surplus days into the following
+	// month the way time.Time.AddDate does: Jan 31 + 1 month = Mar 2 in a
+	// leap year, Feb 29 + 1 year = Mar 1; this is the default
+	EndOfMonthOverflow EndOfMonthPolicy = iota
+	// EndOfMonthClamp pins the day to the last day of the target month:
+	// Jan 31 + 1 month = Feb 29 in a leap year, Feb 29 + 1 year = Feb 28
+	EndOfMonthClamp
+)
+
+// String returns the policy name accepted by ParseEndOfMonthPolicy
+func (p EndOfMonthPolicy) String() string {
+	if p == EndOfMonthClamp {
+		return "clamp"
+	}
+	return "overflow"
+}
+
+// ParseEndOfMonthPolicy parses a policy name, "overflow" or "clamp",
+// case-insensitively; an empty name selects EndOfMonthOverflow
+func ParseEndOfMonthPolicy(name string) (EndOfMonthPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "overflow":
+		return EndOfMonthOverflow, nil
+	case "clamp":
+		return EndOfMonthClamp, nil
+	}
+	return EndOfMonthOverflow, fmt.Errorf("end-of-month policy must be overflow or clamp, not %q", name)
 }
 
 type OptionsDur func(*Dur)
@@ -33,7 +77,7 @@ const (
 	// such as "1.5" can never be partially matched as "5"; long-form unit
 	// names are case-insensitive, matching conv and durmath (brief units stay
 	// case-sensitive because "D" means days while "m" means minutes)
-	expanded string = `(?:^|\s)(\d+(?:\.\d+)?)\s((?i:years?|weeks?|days?|hours?|minutes?|seconds?|milliseconds?|microseconds?|nanoseconds?))`
+	expanded string = `(?:^|\s)(\d+(?:\.\d+)?)\s((?i:years?|quarters?|months?|weeks?|business ?days?|days?|hours?|minutes?|seconds?|milliseconds?|microseconds?|nanoseconds?))`
 	hintMsg  string = "Hint: duplicate durations not allowed; dates in uppercase; times in lowercase"
 
 	// maxUntilIterations is a backstop against unbounded output from the
@@ -80,8 +124,120 @@ func DurWithOutputFormat(outputFormat string) OptionsDur {
 	}
 }
 
+// DurWithLayout renders the results with a Go reference layout, or a name
+// from LayoutPresets such as "RFC3339", instead of DurWithOutputFormat
+func DurWithLayout(layout string) OptionsDur {
+	return func(dur *Dur) {
+		dur.Layout = layout
+	}
+}
+
+// DurWithLocale names the locale for month and weekday names in the output,
+// such as "fr" or "de_DE.UTF-8"; empty means English
+func DurWithLocale(name string) OptionsDur {
+	return func(dur *Dur) {
+		dur.Locale = name
+	}
+}
+
+// DurWithEndOfMonth sets the policy for year, quarter, and month
+// arithmetic landing on a day the target month lacks, such as Jan 31 +
+// 1 month or Feb 29 + 1 year
+func DurWithEndOfMonth(policy EndOfMonthPolicy) OptionsDur {
+	return func(dur *Dur) {
+		dur.EndOfMonth = policy
+	}
+}
+
+// DurWithWeekend sets the weekdays that business-day arithmetic skips;
+// when unset, DefaultWeekend (Saturday and Sunday) is used
+func DurWithWeekend(weekend ...time.Weekday) OptionsDur {
+	return func(dur *Dur) {
+		dur.Weekend = weekend
+	}
+}
+
+// DurWithHolidays sets the holidays that business-day arithmetic skips in
+// addition to the weekend
+func DurWithHolidays(holidays HolidayChecker) OptionsDur {
+	return func(dur *Dur) {
+		dur.Holidays = holidays
+	}
+}
+
+// DurWithBusinessSchedule makes the period count working time on the
+// schedule, so "16h" lands 16 working hours away; the period must then
+// use hours, minutes, seconds, or smaller units
+func DurWithBusinessSchedule(schedule *BusinessSchedule) OptionsDur {
+	return func(dur *Dur) {
+		dur.Schedule = schedule
+	}
+}
+
+// DurWithParser parses From and Until with the Parser's settings instead of
+// the environment's
+func DurWithParser(p *Parser) OptionsDur {
+	return func(dur *Dur) {
+		dur.Parser = p
+	}
+}
+
+// DurWithClock sets the reference instant for relative From and Until
+// values such as "now" or "next friday"
+func DurWithClock(c Clock) OptionsDur {
+	return func(dur *Dur) {
+		dur.Clock = c
+	}
+}
+
+// DurWithInputZone reads a From or Until without a zone of its own as a wall
+// clock in zone: an IANA name, an abbreviation, an alias from
+// ZoneAliasesEnvVar, or a UTC offset, resolved as by ResolveZone; results
+// are in that zone too
+func DurWithInputZone(zone string) OptionsDur {
+	return func(dur *Dur) {
+		dur.InputZone = zone
+	}
+}
+
+// DurWithOutputZone converts each result to zone before it is rendered,
+// resolved and converted by a TimeZoneConverter, so results before 1970 are
+// refused unless the converter given by DurWithTimeZoneConverter allows them
+func DurWithOutputZone(zone string) OptionsDur {
+	return func(dur *Dur) {
+		dur.OutputZone = zone
+	}
+}
+
+// DurWithTimeZoneConverter sets the converter used by DurWithOutputZone,
+// instead of one with the built-in abbreviations and ZoneAliasesEnvVar
+func DurWithTimeZoneConverter(c *TimeZoneConverter) OptionsDur {
+	return func(dur *Dur) {
+		dur.Converter = c
+	}
+}
+
+// parser returns the Parser reading From and Until
+func (dur *Dur) parser() (*Parser, error) {
+	return dur.Parser.withClock(dur.Clock).inZone(dur.InputZone)
+}
+
 func (dur *Dur) String() string {
-	return fmt.Sprintf("From:%v Period:%v Repeat:%v Until:%v OutputFormat:%v", dur.From, dur.Period, dur.Repeat, dur.Until, dur.OutputFormat)
+	return fmt.Sprintf("From:%v Period:%v Repeat:%v Until:%v OutputFormat:%v Layout:%v Locale:%v EndOfMonth:%v Weekend:%v InputZone:%v OutputZone:%v", dur.From, dur.Period, dur.Repeat, dur.Until, dur.OutputFormat, dur.Layout, dur.Locale, dur.EndOfMonth, dur.weekend(), dur.InputZone, dur.OutputZone)
+}
+
+// weekend returns the configured weekend, or DefaultWeekend when unset
+func (dur *Dur) weekend() []time.Weekday {
+	if dur.Weekend == nil {
+		return DefaultWeekend
+	}
+	return dur.Weekend
+}
+
+// isBusinessDay reports whether t counts as a business day under the Dur's
+// weekend and holidays
+func (dur *Dur) isBusinessDay(t time.Time) bool {
+	return isBusinessDay(t, dur.weekend(), dur.Holidays)
 }
 
 func (dur *Dur) Add() ([]string, error) {
@@ -106,11 +262,26 @@ func (dur *Dur) addOrSub(op int) ([]string, error) {
 		return nil, fmt.Errorf("repeat & until are mutually exclusive")
 	}
 
-	from, err := parseDateTimeOrUnix(dur.From)
+	p, err := dur.parser()
+	if err != nil {
+		return nil, err
+	}
+	from, err := p.parse(dur.From)
 	if err != nil {
 		return nil, err
 	}
-	periodMatches, err := parsePeriod(dur.Period)
+	if IsRecurrenceRule(dur.Period) {
+		return dur.recur(from, op, p)
+	}
+	period := dur.Period
+	if isISODuration(period) {
+		// a negative ISO 8601 duration reverses the operation
+		if rest, negative := strings.CutPrefix(period, "-"); negative {
+			period = rest
+			op = opAdd + opSub - op
+		}
+	}
+	periodMatches, err := parsePeriod(period)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +289,7 @@ func (dur *Dur) addOrSub(op int) ([]string, error) {
 	var all []time.Time
 	switch {
 	case dur.Repeat == 0 && dur.Until == "":
-		to, err := applyPeriod(from, periodMatches, op)
+		to, err := dur.applyPeriod(from, periodMatches, op)
 		if err != nil {
 			return nil, err
 		}
@@ -126,14 +297,14 @@ func (dur *Dur) addOrSub(op int) ([]string, error) {
 	case dur.Repeat > 0:
 		to := from
 		for i := 0; i < dur.Repeat; i++ {
-			to, err = applyPeriod(to, periodMatches, op)
+			to, err = dur.step(from, to, periodMatches, op, i+1)
 			if err != nil {
 				return nil, err
 			}
 			all = append(all, to)
 		}
 	default: // until
-		u, err := parseDateTimeOrUnix(dur.Until)
+		u, err := p.parse(dur.Until)
 		if err != nil {
 			return nil, err
 		}
@@ -150,7 +321,7 @@ func (dur *Dur) addOrSub(op int) ([]string, error) {
 			if i >= maxUntilIterations {
 				return nil, fmt.Errorf("until would produce more than %d results", maxUntilIterations)
 			}
-			next, err := applyPeriod(to, periodMatches, op)
+			next, err := dur.step(from, to, periodMatches, op, i+1)
 			if err != nil {
 				return nil, err
 			}
@@ -173,32 +344,111 @@ func (dur *Dur) addOrSub(op int) ([]string, error) {
 	return dur.renderResults(all)
 }
 
+// step computes the n-th result of a repeat or until sequence from the
+// previous result; under EndOfMonthClamp it is instead measured from the
+// start with every amount multiplied by n, because clamping is lossy:
+// stepping Jan 31 by 1 month would otherwise drift to Feb 29, Mar 29, ...
+// rather than staying on the month ends Feb 29, Mar 31, ...
+func (dur *Dur) step(from, prev time.Time, periodMatches [][2]string, op int, n int) (time.Time, error) {
+	if dur.EndOfMonth != EndOfMonthClamp {
+		return dur.applyPeriod(prev, periodMatches, op)
+	}
+	scaled := make([][2]string, len(periodMatches))
+	for i, match := range periodMatches {
+		value, err := strconv.ParseFloat(match[0], 64)
+		if err != nil {
+			return prev, err
+		}
+		scaled[i] = [2]string{strconv.FormatFloat(value*float64(n), 'f', -1, 64), match[1]}
+	}
+	return dur.applyPeriod(from, scaled, op)
+}
+
 // renderResults converts computed date/times to strings, applying the
-// optional strftime output format which also supports the unix time %s modifier
+// optional strftime output format with the extended specifiers, such as %s
+// for Unix seconds and %3N for milliseconds
 func (dur *Dur) renderResults(all []time.Time) ([]string, error) {
-	rendered := make([]string, 0, len(all))
-	if len(dur.OutputFormat) == 0 {
-		for _, t := range all {
-			rendered = append(rendered, t.String())
-		}
-		return rendered, nil
+	return renderTimes(all, dur.OutputFormat, FormatWithLocale(dur.Locale), FormatWithLayout(dur.Layout),
+		FormatWithOutputZone(dur.OutputZone), FormatWithTimeZoneConverter(dur.Converter))
+}
+
+// recur steps through the occurrences of an RRULE period anchored at from:
+// each step moves to the next occurrence after the previous one, so from
+// itself is never a result; p reads Until
+func (dur *Dur) recur(from time.Time, op int, p *Parser) ([]string, error) {
+	if opSub == op {
+		return nil, fmt.Errorf("recurrence rules only run forward: add %q instead of subtracting it", dur.Period)
+	}
+	if dur.Schedule != nil {
+		return nil, fmt.Errorf("recurrence rules cannot be combined with business hours")
+	}
+	count := dur.Repeat
+	if count == 0 && dur.Until == "" {
+		count = 1
+	}
+	r := NewRecurrence(RecurrenceWithRule(dur.Period), RecurrenceWithFrom(dur.From),
+		RecurrenceWithUntil(dur.Until), RecurrenceWithLocation(from.Location()))
+	r.Parser = p
+	if count > 0 {
+		// from may itself be an occurrence, which is dropped below
+		r.Count = count + 1
+	}
+	all, err := r.Times()
+	if err != nil {
+		return nil, err
+	}
+	for len(all) > 0 && !all[0].After(from) {
+		all = all[1:]
+	}
+	if count > 0 && len(all) > count {
+		all = all[:count]
+	}
+	return dur.renderResults(all)
+}
+
+// renderTimes formats each time with the strftime outputFormat and options,
+// or with time.Time.String when outputFormat is empty, after converting it
+// to the zone of FormatWithOutputZone, if any
+func renderTimes(all []time.Time, outputFormat string, options ...OptionsFormat) ([]string, error) {
+	format, ok, err := newFormatter(outputFormat, options...)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		format = time.Time.String
 	}
-	f, err := strftime.New(dur.OutputFormat, strftime.WithUnixSeconds('s'))
+	convert, err := newOutputConverter(options...)
 	if err != nil {
 		return nil, err
 	}
+	rendered := make([]string, 0, len(all))
 	for _, t := range all {
-		rendered = append(rendered, f.FormatString(t))
+		if convert != nil {
+			if t, err = convert(t); err != nil {
+				return nil, err
+			}
+		}
+		rendered = append(rendered, format(t))
 	}
 	return rendered, nil
 }
 
-// parsePeriod parses a period in either long or brief format into
+// parsePeriod parses a period in long, brief, or ISO 8601 format into
 // (amount, unit) pairs, erroring if any part of the period is not
 // understood; error messages quote the caller's original input, never the
 // internal brief-to-long expansion
 func parsePeriod(period string) ([][2]string, error) {
 	original := period
+	if isISODuration(period) {
+		expandedISO, negative, err := expandISODuration(period)
+		if err != nil {
+			return nil, err
+		}
+		if negative {
+			return nil, fmt.Errorf("[parsePeriod] negative duration not allowed here: %s", original)
+		}
+		period = expandedISO
+	}
 	indexes := expandedRegexp.FindAllStringSubmatchIndex(period, -1)
 	if len(indexes) == 0 {
 		// brief format is being used so first expand it to the long format
@@ -232,15 +482,20 @@ func parsePeriod(period string) ([][2]string, error) {
 
 // applyPeriod applies each (amount, unit) pair of a parsed period to a date/time
 // when op==opAdd, then add; when op==opSub, then subtract
-// the integer part of an amount uses datecalc's calendar-aware functions; any
-// fractional part is applied as nanoseconds
-func applyPeriod(to time.Time, periodMatches [][2]string, op int) (time.Time, error) {
+// the integer part of an amount uses datecalc's calendar-aware functions,
+// with the Dur's end-of-month policy, weekend, and holidays; any fractional
+// part is applied as nanoseconds, except for months, quarters, and business
+// days, which have no fixed length and must be whole
+func (dur *Dur) applyPeriod(to time.Time, periodMatches [][2]string, op int) (time.Time, error) {
 	sign := +1
 	if op == opSub {
 		sign = -1
 	}
+	if dur.Schedule != nil {
+		return dur.applyBusinessHours(to, periodMatches, sign)
+	}
 	for _, match := range periodMatches {
-		amount, word := match[0], normalizeUnit(match[1])
+		amount, word := match[0], normalizeUnit(strings.ReplaceAll(match[1], " ", ""))
 		value, err := strconv.ParseFloat(amount, 64)
 		if err != nil {
 			return to, err
@@ -249,7 +504,14 @@ func applyPeriod(to time.Time, periodMatches [][2]string, op int) (time.Time, er
 			return to, fmt.Errorf("amount too large: %s", amount)
 		}
 		whole, frac := math.Modf(value)
-		to, err = datecalc.Apply(to, word, int(whole), sign)
+		if frac > 0 && variableLengthUnits[word] {
+			return to, fmt.Errorf("amounts of %s must be whole numbers: %s", strings.ToLower(match[1]), amount)
+		}
+		if word == "businessday" {
+			to, err = datecalc.AddBusinessDays(to, int(whole), sign, dur.isBusinessDay)
+		} else {
+			to, err = datecalc.ApplyWithPolicy(to, word, int(whole), sign, datecalc.EndOfMonth(dur.EndOfMonth))
+		}
 		if err != nil {
 			return to, err
 		}
@@ -267,6 +529,37 @@ func applyPeriod(to time.Time, periodMatches [][2]string, op int) (time.Time, er
 	return to, nil
 }
 
+// applyBusinessHours adds the period, as working time, to a date/time on
+// the Dur's schedule; calendar units are rejected because a working day or
+// week has no fixed number of working hours
+func (dur *Dur) applyBusinessHours(to time.Time, periodMatches [][2]string, sign int) (time.Time, error) {
+	var total int64
+	for _, match := range periodMatches {
+		word := normalizeUnit(strings.ReplaceAll(match[1], " ", ""))
+		if _, isClock := businessHoursUnits[word]; !isClock {
+			unit := strings.ToLower(match[1])
+			if word == "businessday" {
+				unit = "business days (B)"
+			}
+			return to, fmt.Errorf("business hours count working time; use hours, minutes, or seconds, not %s", unit)
+		}
+		ns, err := amountToNanos(match[0], unitNanos[word])
+		if err != nil {
+			return to, err
+		}
+		if total, err = addInt64Checked(total, ns); err != nil {
+			return to, err
+		}
+	}
+	return dur.Schedule.Add(to, time.Duration(int64(sign)*total))
+}
+
+// businessHoursUnits are the units a business-hours period may use
+var businessHoursUnits = map[string]bool{
+	"hour": true, "minute": true, "second": true,
+	"millisecond": true, "microsecond": true, "nanosecond": true,
+}
+
 // expandPeriod convert a brief style period into a long period
 // only allow one replacement per each period
 // Ex: 1h2m3s => 1 hour 2 minutes 3 seconds
@@ -293,7 +586,9 @@ func expandPeriod(period string) (string, error) {
 	s = strings.Replace(s, "h", "\x06", 1)
 	s = strings.Replace(s, "D", "\x07", 1)
 	s = strings.Replace(s, "W", "\x08", 1)
-	// Month (M) not supported
+	s = strings.Replace(s, "M", "\x0c", 1)
+	s = strings.Replace(s, "Q", "\x0e", 1)
+	s = strings.Replace(s, "B", "\x0f", 1)
 	s = strings.Replace(s, "Y", "\x0b", 1)
 
 	// now convert from the unique string back to the corresponding duration
@@ -306,7 +601,9 @@ func expandPeriod(period string) (string, error) {
 	p = strings.Replace(p, "\x06", " hours ", 1)
 	p = strings.Replace(p, "\x07", " days ", 1)
 	p = strings.Replace(p, "\x08", " weeks ", 1)
-	// Month (M) not supported
+	p = strings.Replace(p, "\x0c", " months ", 1)
+	p = strings.Replace(p, "\x0e", " quarters ", 1)
+	p = strings.Replace(p, "\x0f", " businessdays ", 1)
 	p = strings.Replace(p, "\x0b", " years ", 1)
 
 	// ensure each time & period was successfully replaced
@@ -1,6 +1,7 @@
 package DateTimeMate
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -507,9 +508,10 @@ func TestDurRelativeUntil(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	// "tomorrow" resolves to now+24h, so the span covered from start of day
-	// grows with the wall clock; the result count varies with the time of
-	// day, but each entry is deterministic: start of day plus i+1 periods
+	// "tomorrow" resolves to this time of day tomorrow, so the span covered
+	// from start of day grows with the wall clock; the result count varies
+	// with the time of day, but each entry is deterministic: start of day
+	// plus i+1 periods
 	if len(future) < 3 || len(future) > 6 {
 		t.Fatalf("[from: %v] expected 3 to 6 results, got %d: %v", from, len(future), future)
 	}
@@ -521,3 +523,145 @@ func TestDurRelativeUntil(t *testing.T) {
 		}
 	}
 }
+
+func TestDurMonthsAndQuarters(t *testing.T) {
+	t.Parallel()
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "1 month", "2024-07-15 08:00:00", "2024-05-15 08:00:00")
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "2M", "2024-08-15 08:00:00", "2024-04-15 08:00:00")
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "1 quarter", "2024-09-15 08:00:00", "2024-03-15 08:00:00")
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "2Q", "2024-12-15 08:00:00", "2023-12-15 08:00:00")
+	// brief months are uppercase M, minutes stay lowercase m
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "1M30m", "2024-07-15 08:30:00", "2024-05-15 07:30:00")
+	// long-form unit names are case-insensitive
+	testDurAddSubContains(t, "2024-06-15 08:00:00", "1 Month", "2024-07-15 08:00:00", "2024-05-15 08:00:00")
+}
+
+func TestDurEndOfMonthPolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from, period string
+		policy       EndOfMonthPolicy
+		correctAdd   string
+		correctSub   string
+	}{
+		{"2024-01-31", "1M", EndOfMonthOverflow, "2024-03-02", "2023-12-31"},
+		{"2024-01-31", "1M", EndOfMonthClamp, "2024-02-29", "2023-12-31"},
+		{"2024-03-31", "1M", EndOfMonthClamp, "2024-04-30", "2024-02-29"},
+		{"2024-02-29", "1Y", EndOfMonthOverflow, "2025-03-01", "2023-03-01"},
+		{"2024-02-29", "1Y", EndOfMonthClamp, "2025-02-28", "2023-02-28"},
+		{"2024-11-30", "1Q", EndOfMonthClamp, "2025-02-28", "2024-08-30"},
+	}
+	for _, tt := range tests {
+		dur := NewDur(DurWithFrom(tt.from), DurWithDur(tt.period), DurWithEndOfMonth(tt.policy), DurWithOutputFormat("%F"))
+		future, err := dur.Add()
+		if err != nil {
+			t.Fatal(err)
+		}
+		past, err := dur.Sub()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if future[0] != tt.correctAdd || past[0] != tt.correctSub {
+			t.Errorf("[from: %v] [period: %v] [policy: %v] [computed: %v %v] != [correct: %v %v]", tt.from, tt.period, tt.policy, future[0], past[0], tt.correctAdd, tt.correctSub)
+		}
+	}
+}
+
+func TestDurEndOfMonthClampSequence(t *testing.T) {
+	t.Parallel()
+	// clamped steps are measured from the start, so month ends stay on
+	// month ends instead of drifting to the 29th after February
+	dur := NewDur(
+		DurWithFrom("2024-01-31"),
+		DurWithDur("1M"),
+		DurWithRepeat(4),
+		DurWithEndOfMonth(EndOfMonthClamp),
+		DurWithOutputFormat("%F"))
+	future, err := dur.Add()
+	if err != nil {
+		t.Fatal(err)
+	}
+	correct := []string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"}
+	if strings.Join(future, " ") != strings.Join(correct, " ") {
+		t.Errorf("[computed: %v] != [correct: %v]", future, correct)
+	}
+
+	dur = NewDur(
+		DurWithFrom("2024-01-31"),
+		DurWithDur("1M"),
+		DurWithUntil("2023-10-01"),
+		DurWithEndOfMonth(EndOfMonthClamp),
+		DurWithOutputFormat("%F"))
+	past, err := dur.Sub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	correct = []string{"2023-12-31", "2023-11-30", "2023-10-31"}
+	if strings.Join(past, " ") != strings.Join(correct, " ") {
+		t.Errorf("[computed: %v] != [correct: %v]", past, correct)
+	}
+}
+
+func TestDurFractionalMonthRejected(t *testing.T) {
+	t.Parallel()
+	for _, period := range []string{"1.5 months", "0.5Q"} {
+		dur := NewDur(DurWithFrom("2024-01-01"), DurWithDur(period))
+		if _, err := dur.Add(); err == nil {
+			t.Errorf("expected an error for fractional period %q, got nil", period)
+		}
+	}
+}
+
+func TestParseEndOfMonthPolicy(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]EndOfMonthPolicy{"": EndOfMonthOverflow, "overflow": EndOfMonthOverflow, "Clamp": EndOfMonthClamp} {
+		got, err := ParseEndOfMonthPolicy(name)
+		if err != nil || got != want {
+			t.Errorf("ParseEndOfMonthPolicy(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseEndOfMonthPolicy("round"); err == nil {
+		t.Error("expected an error for an unknown policy, got nil")
+	}
+}
+
+func TestDurWithInputZone(t *testing.T) {
+	t.Parallel()
+	dur := NewDur(DurWithFrom("2026-03-08 01:00"), DurWithDur("2h"), DurWithInputZone("America/Chicago"),
+		DurWithUntil("2026-03-08 06:00"), DurWithOutputFormat("%H:%M %z"))
+	got, err := dur.Add()
+	if want := []string{"04:00 -0500", "06:00 -0500"}; err != nil || strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Add = %q, %v, want %q", got, err, want)
+	}
+	dur = NewDur(DurWithFrom("2026-07-01"), DurWithDur("FREQ=WEEKLY"), DurWithInputZone("19800"), DurWithOutputFormat("%F %z"))
+	if got, err := dur.Add(); err != nil || len(got) != 1 || got[0] != "2026-07-08 +0530" {
+		t.Errorf("Add of a recurrence = %q, %v", got, err)
+	}
+	if _, err := NewDur(DurWithFrom("2026-07-01"), DurWithDur("1h"), DurWithInputZone("+0530")).Add(); err == nil {
+		t.Error("Add in +0530: want error, offsets are in seconds")
+	}
+}
+
+func TestDurWithOutputZone(t *testing.T) {
+	t.Parallel()
+	dur := NewDur(DurWithFrom("2026-10-18T09:00:00Z"), DurWithDur("1D"), DurWithRepeat(2), DurWithOutputZone("Asia/Tokyo"))
+	got, err := dur.Add()
+	if want := "2026-10-19 18:00:00 +0900 JST,2026-10-20 18:00:00 +0900 JST"; err != nil || strings.Join(got, ",") != want {
+		t.Errorf("Add = %q, %v, want %q", got, err, want)
+	}
+	// an input zone and an output zone together
+	dur = NewDur(DurWithFrom("2026-03-08 01:00"), DurWithDur("FREQ=DAILY"), DurWithInputZone("America/Chicago"),
+		DurWithOutputZone("UTC"), DurWithOutputFormat("%F %H:%M %Z"))
+	if got, err := dur.Add(); err != nil || len(got) != 1 || got[0] != "2026-03-09 06:00 UTC" {
+		t.Errorf("Add of a recurrence = %q, %v", got, err)
+	}
+
+	dur = NewDur(DurWithFrom("1965-01-01T00:00:00Z"), DurWithDur("1D"), DurWithOutputZone("UTC"))
+	if _, err := dur.Add(); !errors.Is(err, ErrPre1970) {
+		t.Errorf("Add before 1970: err = %v, want ErrPre1970", err)
+	}
+	dur.Converter = NewTimeZoneConverter(TimeZoneConverterWithAllowPre1970(true))
+	if got, err := dur.Add(); err != nil || len(got) != 1 || got[0] != "1965-01-02 00:00:00 +0000 UTC" {
+		t.Errorf("Add before 1970 with AllowPre1970 = %q, %v", got, err)
+	}
+}
@@ -22,7 +22,9 @@ var ErrNegativeDuration = errors.New("durmath does not accept negative durations
 // DurMath adds or subtracts two durations, First and Second, which may be
 // expressed in different units. Target optionally converts the result to a
 // specific group of units; when empty, the result is a full breakdown from
-// years down to seconds. Brief renders compact output such as "2h15m".
+// years down to seconds. Brief renders compact output such as "2h15m", and
+// ISO renders an ISO 8601 duration such as "PT2H15M", broken down from days
+// when there is no Target.
 // Decimals sets the number of decimal places on the smallest output unit.
 // Absolute renders the result without a sign, e.g. -15 minutes becomes
 // 15 minutes.
@@ -31,6 +33,7 @@ type DurMath struct {
 	Second   string
 	Target   string
 	Brief    bool
+	ISO      bool
 	Decimals int
 	Absolute bool
 }
@@ -76,6 +79,13 @@ func DurMathWithBrief(brief bool) OptionsDurMath {
 	}
 }
 
+// DurMathWithISO renders the result as an ISO 8601 duration.
+func DurMathWithISO(iso bool) OptionsDurMath {
+	return func(dm *DurMath) {
+		dm.ISO = iso
+	}
+}
+
 // DurMathWithDecimals sets the number of decimal places used when formatting
 // the last (smallest) output unit; 0 keeps the default integer truncation
 func DurMathWithDecimals(decimals int) OptionsDurMath {
@@ -94,7 +104,7 @@ func DurMathWithAbsolute(absolute bool) OptionsDurMath {
 
 // String returns a human-readable summary of the DurMath configuration.
 func (dm *DurMath) String() string {
-	return fmt.Sprintf("First:%v Second:%v Target:%v Brief:%v Decimals:%v Absolute:%v", dm.First, dm.Second, dm.Target, dm.Brief, dm.Decimals, dm.Absolute)
+	return fmt.Sprintf("First:%v Second:%v Target:%v Brief:%v ISO:%v Decimals:%v Absolute:%v", dm.First, dm.Second, dm.Target, dm.Brief, dm.ISO, dm.Decimals, dm.Absolute)
 }
 
 // Add returns the sum of the two durations.
@@ -135,6 +145,9 @@ func (dm *DurMath) compute(subtract bool) (string, error) {
 	if dm.Decimals < 0 || dm.Decimals > 9 {
 		return "", fmt.Errorf("decimals must be between 0 and 9: %d", dm.Decimals)
 	}
+	if dm.Brief && dm.ISO {
+		return "", errBriefAndISO
+	}
 	if err := checkNegativeDuration(dm.First); err != nil {
 		return "", err
 	}
@@ -166,12 +179,20 @@ func (dm *DurMath) compute(subtract bool) (string, error) {
 	}
 
 	units := durMathDefaultUnits
-	if dm.Target != "" {
+	switch {
+	case dm.Target != "":
 		units, err = resolveTargetUnits(dm.Target)
 		if err != nil {
 			return "", err
 		}
-	} else if result%nanosPerSecond != 0 {
+		if dm.ISO {
+			if err := checkISOTargetUnits(units); err != nil {
+				return "", err
+			}
+		}
+	case dm.ISO:
+		units = isoDefaultUnits
+	case result%nanosPerSecond != 0:
 		// extend with sub-second units only when the result carries a
 		// sub-second remainder
 		units = durMathAllUnits
@@ -179,6 +200,9 @@ func (dm *DurMath) compute(subtract bool) (string, error) {
 
 	formatter := &Conv{Decimals: dm.Decimals}
 	out := formatter.formatTarget(result, units)
+	if dm.ISO {
+		return formatISODuration(out)
+	}
 	if dm.Brief {
 		out = shrinkPeriod(out)
 	}
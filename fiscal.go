@@ -0,0 +1,305 @@
+// fiscal.go locates dates in fiscal calendars, whose years start in any
+// month and may follow a 4-4-5, 4-5-4, or 5-4-4 retail week pattern
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+	"github.com/jftuga/DateTimeMate/internal/holiday"
+)
+
+// FiscalCalendar defines a fiscal year of 4 quarters and 12 periods.
+//
+// With an empty Pattern, the year starts on the first of StartMonth and its
+// periods are calendar months. With a retail Pattern, "4-4-5", "4-5-4", or
+// "5-4-4", the weeks in each quarter's three periods, the year is 52 or 53
+// whole weeks starting on the FirstDay nearest the first of StartMonth, and
+// a 53rd week joins the last period. Either way, week 1 starts on the
+// year's first day and later weeks start on FirstDay.
+//
+// A fiscal year is labeled by the calendar year in which it ends, so a year
+// starting in October 2026 is FY2027, unless LabelByStartYear is set.
+type FiscalCalendar struct {
+	StartMonth       time.Month
+	Pattern          string
+	FirstDay         time.Weekday
+	LabelByStartYear bool
+}
+
+// fiscal units for FiscalCalendar.Bounds
+const (
+	FiscalYear    = datecalc.FiscalYear
+	FiscalQuarter = datecalc.FiscalQuarter
+	FiscalPeriod  = datecalc.FiscalPeriod
+	FiscalWeek    = datecalc.FiscalWeek
+)
+
+// FiscalPatterns are the retail week patterns a FiscalCalendar accepts
+var FiscalPatterns = []string{"4-4-5", "4-5-4", "5-4-4"}
+
+// NewFiscalCalendar returns a fiscal calendar whose year starts in
+// startMonth (1-12); pattern is empty or "calendar" for calendar-month
+// periods, or one of FiscalPatterns, and firstDay is a weekday name, full
+// or abbreviated
+func NewFiscalCalendar(startMonth int, pattern, firstDay string) (FiscalCalendar, error) {
+	day, err := holiday.ParseWeekday(firstDay)
+	if err != nil {
+		return FiscalCalendar{}, err
+	}
+	pattern = strings.TrimSpace(pattern)
+	if strings.EqualFold(pattern, "calendar") {
+		pattern = ""
+	}
+	c := FiscalCalendar{StartMonth: time.Month(startMonth), Pattern: pattern, FirstDay: day}
+	return c, c.validate()
+}
+
+func (c FiscalCalendar) validate() error {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return fmt.Errorf("fiscal year start month must be between 1 and 12: %d", c.StartMonth)
+	}
+	if c.FirstDay < time.Sunday || c.FirstDay > time.Saturday {
+		return fmt.Errorf("invalid first day of the week: %d", c.FirstDay)
+	}
+	if c.Pattern != "" && !slices.Contains(FiscalPatterns, c.Pattern) {
+		return fmt.Errorf("fiscal week pattern must be calendar or one of %s, not %q", strings.Join(FiscalPatterns, ", "), c.Pattern)
+	}
+	return nil
+}
+
+func (c FiscalCalendar) calc() datecalc.FiscalCalendar {
+	calc := datecalc.FiscalCalendar{StartMonth: c.StartMonth, FirstDay: c.FirstDay}
+	for _, weeks := range strings.Split(c.Pattern, "-") {
+		if n, err := strconv.Atoi(weeks); err == nil {
+			calc.Pattern = append(calc.Pattern, n)
+		}
+	}
+	return calc
+}
+
+// labelOffset is the difference between a fiscal year's label and the
+// calendar year in which it starts
+func (c FiscalCalendar) labelOffset() int {
+	if c.LabelByStartYear || c.StartMonth == time.January {
+		return 0
+	}
+	return 1
+}
+
+// FiscalDate is a date's place in a fiscal calendar: its labeled fiscal
+// year, quarter 1-4, period 1-12, and week 1-53
+type FiscalDate struct {
+	Year    int
+	Quarter int
+	Period  int
+	Week    int
+}
+
+// String labels the fiscal date, such as "FY2027 Q1 P02 W06"
+func (fd FiscalDate) String() string {
+	return fmt.Sprintf("FY%04d Q%d P%02d W%02d", fd.Year, fd.Quarter, fd.Period, fd.Week)
+}
+
+// Locate returns the fiscal year, quarter, period, and week of t's
+// calendar date
+func (c FiscalCalendar) Locate(t time.Time) (FiscalDate, error) {
+	if err := c.validate(); err != nil {
+		return FiscalDate{}, err
+	}
+	fd := c.calc().Locate(t)
+	return FiscalDate{Year: fd.Year + c.labelOffset(), Quarter: fd.Quarter, Period: fd.Period, Week: fd.Week}, nil
+}
+
+// WeeksIn returns the number of weeks in the labeled fiscal year; a
+// calendar-month year counts its partial first and last weeks
+func (c FiscalCalendar) WeeksIn(year int) int {
+	return c.calc().WeeksIn(year - c.labelOffset())
+}
+
+// Bounds returns the first and last days, at midnight in loc, of the
+// labeled fiscal year or of its quarter, period, or week n; n is ignored
+// for FiscalYear
+func (c FiscalCalendar) Bounds(year int, unit string, n int, loc *time.Location) (start, end time.Time, err error) {
+	if err := c.validate(); err != nil {
+		return start, end, err
+	}
+	start, end, err = c.calc().Bounds(year-c.labelOffset(), unit, n, loc)
+	if err != nil {
+		return start, end, fmt.Errorf("FY%04d: %w", year, err)
+	}
+	return start, end, nil
+}
+
+// fiscalSpecRegexp matches a fiscal year, quarter, period, or week, such as
+// "FY2027", "FY2027-Q1", "FY2027-P02", or "FY2027-W06"
+var fiscalSpecRegexp = regexp.MustCompile(`^[Ff][Yy](\d{4})(?:-?([QqPpWw])(\d{1,2}))?$`)
+
+// DefaultFiscalOutputFormat renders the first and last days of fiscal periods
+const DefaultFiscalOutputFormat = "%Y-%m-%d %a"
+
+// Fiscal describes Source under Calendar. Source is either a fiscal year,
+// quarter, period, or week, such as "FY2027-Q1", or any date/time, whose
+// fiscal year, quarter, period, and week are found.
+type Fiscal struct {
+	Source       string
+	Calendar     FiscalCalendar
+	OutputFormat string
+	Locale       string
+	Parser       *Parser
+	Clock        Clock
+}
+
+type OptionsFiscal func(*Fiscal)
+
+// NewFiscal returns a Fiscal configured with the given options; its
+// calendar defaults to years starting in January with weeks starting on
+// Monday
+func NewFiscal(options ...OptionsFiscal) *Fiscal {
+	f := &Fiscal{Calendar: FiscalCalendar{StartMonth: time.January, FirstDay: time.Monday}}
+	for _, opt := range options {
+		opt(f)
+	}
+	return f
+}
+
+func FiscalWithSource(source string) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.Source = source
+	}
+}
+
+func FiscalWithCalendar(calendar FiscalCalendar) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.Calendar = calendar
+	}
+}
+
+func FiscalWithOutputFormat(outputFormat string) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.OutputFormat = outputFormat
+	}
+}
+
+func FiscalWithLocale(name string) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.Locale = name
+	}
+}
+
+// FiscalWithParser parses Source with the Parser's settings instead of the
+// environment's
+func FiscalWithParser(p *Parser) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.Parser = p
+	}
+}
+
+// FiscalWithClock sets the reference instant for a relative Source such as
+// "today" or "next month"
+func FiscalWithClock(c Clock) OptionsFiscal {
+	return func(f *Fiscal) {
+		f.Clock = c
+	}
+}
+
+func (f *Fiscal) String() string {
+	return fmt.Sprintf("Source:%v Calendar:%+v OutputFormat:%v Locale:%v", f.Source, f.Calendar, f.OutputFormat, f.Locale)
+}
+
+// fiscalSpan is one fiscal year, quarter, period, or week and its bounds
+type fiscalSpan struct {
+	unit  string
+	label string
+	start time.Time
+	end   time.Time
+}
+
+// spans returns the fiscal span named by Source, or the year, quarter,
+// period, and week holding the date in Source
+func (f *Fiscal) spans() ([]fiscalSpan, error) {
+	source := strings.TrimSpace(f.Source)
+	if m := fiscalSpecRegexp.FindStringSubmatch(source); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		unit, n := FiscalYear, 0
+		if m[2] != "" {
+			unit = map[string]string{"q": FiscalQuarter, "p": FiscalPeriod, "w": FiscalWeek}[strings.ToLower(m[2])]
+			n, _ = strconv.Atoi(m[3])
+		}
+		span, err := f.span(year, unit, n, time.Local)
+		if err != nil {
+			return nil, err
+		}
+		return []fiscalSpan{span}, nil
+	}
+	t, err := f.Parser.withClock(f.Clock).parse(source)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := f.Calendar.Locate(t)
+	if err != nil {
+		return nil, err
+	}
+	units := []string{FiscalYear, FiscalQuarter, FiscalPeriod, FiscalWeek}
+	numbers := []int{0, fd.Quarter, fd.Period, fd.Week}
+	all := make([]fiscalSpan, 0, len(units))
+	for i, unit := range units {
+		span, err := f.span(fd.Year, unit, numbers[i], t.Location())
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, span)
+	}
+	return all, nil
+}
+
+func (f *Fiscal) span(year int, unit string, n int, loc *time.Location) (fiscalSpan, error) {
+	start, end, err := f.Calendar.Bounds(year, unit, n, loc)
+	if err != nil {
+		return fiscalSpan{}, err
+	}
+	label := fmt.Sprintf("FY%04d", year)
+	switch unit {
+	case FiscalQuarter:
+		label += fmt.Sprintf("-Q%d", n)
+	case FiscalPeriod:
+		label += fmt.Sprintf("-P%02d", n)
+	case FiscalWeek:
+		label += fmt.Sprintf("-W%02d", n)
+	}
+	return fiscalSpan{unit: unit, label: label, start: start, end: end}, nil
+}
+
+// Expand returns one line per fiscal span, such as
+// "quarter  FY2027-Q1  2026-10-01 Thu - 2026-12-31 Thu", with the first and
+// last days rendered with OutputFormat, or DefaultFiscalOutputFormat when
+// empty; a date yields its year, quarter, period, and week, in that order
+func (f *Fiscal) Expand() ([]string, error) {
+	all, err := f.spans()
+	if err != nil {
+		return nil, err
+	}
+	outputFormat := f.OutputFormat
+	if outputFormat == "" {
+		outputFormat = DefaultFiscalOutputFormat
+	}
+	unitWidth, labelWidth := 0, 0
+	for _, span := range all {
+		unitWidth, labelWidth = max(unitWidth, len(span.unit)), max(labelWidth, len(span.label))
+	}
+	lines := make([]string, 0, len(all))
+	for _, span := range all {
+		days, err := renderTimes([]time.Time{span.start, span.end}, outputFormat, FormatWithLocale(f.Locale))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %-*s  %s - %s", unitWidth, span.unit, labelWidth, span.label, days[0], days[1]))
+	}
+	return lines, nil
+}
@@ -0,0 +1,104 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFiscalExpand(t *testing.T) {
+	t.Parallel()
+	october, err := NewFiscalCalendar(10, "", "mon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	retail, err := NewFiscalCalendar(2, "4-5-4", "sun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	retail.LabelByStartYear = true
+	tests := []struct {
+		name   string
+		fiscal *Fiscal
+		lines  []string
+	}{
+		{
+			"date in a year starting in October",
+			NewFiscal(FiscalWithSource("2026-11-03"), FiscalWithCalendar(october)),
+			[]string{
+				"year     FY2027      2026-10-01 Thu - 2027-09-30 Thu",
+				"quarter  FY2027-Q1   2026-10-01 Thu - 2026-12-31 Thu",
+				"period   FY2027-P02  2026-11-01 Sun - 2026-11-30 Mon",
+				"week     FY2027-W06  2026-11-02 Mon - 2026-11-08 Sun",
+			},
+		},
+		{
+			"quarter spec",
+			NewFiscal(FiscalWithSource("fy2027-q2"), FiscalWithCalendar(october), FiscalWithOutputFormat("%m/%d/%Y")),
+			[]string{"quarter  FY2027-Q2  01/01/2027 - 03/31/2027"},
+		},
+		{
+			"retail 53rd week joins the last period",
+			NewFiscal(FiscalWithSource("2024-02-03 18:00"), FiscalWithCalendar(retail)),
+			[]string{
+				"year     FY2023      2023-01-29 Sun - 2024-02-03 Sat",
+				"quarter  FY2023-Q4   2023-10-29 Sun - 2024-02-03 Sat",
+				"period   FY2023-P12  2023-12-31 Sun - 2024-02-03 Sat",
+				"week     FY2023-W53  2024-01-28 Sun - 2024-02-03 Sat",
+			},
+		},
+		{
+			"default calendar year",
+			NewFiscal(FiscalWithSource("FY2026-W01")),
+			[]string{"week  FY2026-W01  2026-01-01 Thu - 2026-01-04 Sun"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := tt.fiscal.Expand()
+		if err != nil || !slices.Equal(got, tt.lines) {
+			t.Errorf("%s: Expand() = %q, %v; want %q", tt.name, got, err, tt.lines)
+		}
+	}
+}
+
+func TestFiscalLocate(t *testing.T) {
+	t.Parallel()
+	july := FiscalCalendar{StartMonth: time.July, FirstDay: time.Sunday}
+	date := time.Date(2026, 6, 30, 23, 0, 0, 0, time.UTC)
+	if fd, err := july.Locate(date); err != nil || fd.String() != "FY2026 Q4 P12 W53" {
+		t.Errorf("Locate(2026-06-30) = %v, %v; want FY2026 Q4 P12 W53", fd, err)
+	}
+	july.LabelByStartYear = true
+	if fd, err := july.Locate(date); err != nil || fd.Year != 2025 {
+		t.Errorf("Locate(2026-06-30) labeled by start year = %v, %v; want FY2025", fd, err)
+	}
+	if weeks := july.WeeksIn(2025); weeks != 53 {
+		t.Errorf("WeeksIn(2025) = %d, want 53", weeks)
+	}
+}
+
+func TestFiscalInvalid(t *testing.T) {
+	t.Parallel()
+	if _, err := NewFiscalCalendar(13, "", "mon"); err == nil || !strings.Contains(err.Error(), "between 1 and 12") {
+		t.Errorf("NewFiscalCalendar(13) error = %v, want a start month error", err)
+	}
+	if _, err := NewFiscalCalendar(1, "4-4-4", "mon"); err == nil || !strings.Contains(err.Error(), "4-4-5, 4-5-4, 5-4-4") {
+		t.Errorf("NewFiscalCalendar(4-4-4) error = %v, want a pattern error", err)
+	}
+	if _, err := NewFiscalCalendar(1, "calendar", "someday"); err == nil {
+		t.Error("NewFiscalCalendar(someday) expected an error")
+	}
+	tests := map[*Fiscal]string{
+		NewFiscal(FiscalWithSource("FY2027-Q5")):                                                                 "quarter 5 is out of range",
+		NewFiscal(FiscalWithSource("FY2027-W54")):                                                                "FY2027: fiscal week 54",
+		NewFiscal(FiscalWithSource("not a date")):                                                                "not a date",
+		NewFiscal(FiscalWithSource("2026-11-03"), FiscalWithCalendar(FiscalCalendar{})):                          "start month",
+		NewFiscal(FiscalWithSource("FY2027"), FiscalWithCalendar(FiscalCalendar{StartMonth: 1, Pattern: "4-4"})): "pattern",
+	}
+	for fiscal, want := range tests {
+		if _, err := fiscal.Expand(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%v: Expand() error = %v, want it to mention %q", fiscal, err, want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// holiday.go exposes the holiday subsystem: a HolidayCalendar combines
+// built-in country sets, holiday files, and individual dates, lists the
+// holidays observed in a year, and serves as the HolidayChecker for
+// business-day arithmetic.
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/holiday"
+)
+
+// Holiday is one holiday occurrence: Date is the day it is observed and
+// Actual the day it falls on, both at midnight local time; they differ when
+// a holiday on a weekend is observed on a weekday
+type Holiday struct {
+	Name   string
+	Set    string
+	Date   time.Time
+	Actual time.Time
+}
+
+// Observed reports whether the holiday was moved off its actual date
+func (h Holiday) Observed() bool {
+	return !h.Date.Equal(h.Actual)
+}
+
+// HolidayCalendar is a HolidayChecker over one or more holiday sets; a date
+// is a holiday when any set observes a holiday on it
+type HolidayCalendar struct {
+	sets []*holiday.Set
+
+	mu    sync.Mutex
+	years map[int]map[string]bool
+}
+
+// HolidaySetNames returns the names of the built-in holiday sets, such as
+// US, UK, CA, DE, and FR
+func HolidaySetNames() []string {
+	return holiday.Names()
+}
+
+// LoadHolidayCalendar builds a HolidayCalendar from specs, each of which is
+// a built-in set name (case-insensitive), the path of a .json, .csv, or
+// .ics holiday file (chosen by extension), or a single date parsed the way
+// every sub-command parses dates
+func LoadHolidayCalendar(specs ...string) (*HolidayCalendar, error) {
+	cal := &HolidayCalendar{years: make(map[int]map[string]bool)}
+	dates := &holiday.Set{Name: "dates"}
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		if set, ok := holiday.Builtin(spec); ok {
+			cal.sets = append(cal.sets, set)
+			continue
+		}
+		if holiday.IsFile(spec) {
+			set, err := holiday.Load(spec)
+			if err != nil {
+				return nil, err
+			}
+			cal.sets = append(cal.sets, set)
+			continue
+		}
+		t, err := parseDateTimeOrUnix(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holidays %q: not a built-in set (%s), a holiday file, or a date",
+				spec, strings.Join(HolidaySetNames(), ", "))
+		}
+		date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		dates.Dates = append(dates.Dates, holiday.Holiday{Date: date, Actual: date})
+	}
+	if len(dates.Dates) > 0 {
+		cal.sets = append(cal.sets, dates)
+	}
+	return cal, nil
+}
+
+// Holidays returns the holidays observed in the given year across every
+// set, sorted by date
+func (c *HolidayCalendar) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for _, set := range c.sets {
+		for _, h := range set.Holidays(year) {
+			holidays = append(holidays, Holiday{
+				Name:   h.Name,
+				Set:    set.Name,
+				Date:   localDate(h.Date),
+				Actual: localDate(h.Actual),
+			})
+		}
+	}
+	// each set is already sorted; a stable sort keeps the sets' order for
+	// holidays sharing a date
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays
+}
+
+// IsHoliday reports whether t's calendar date, in t's own location, is an
+// observed holiday in any set
+func (c *HolidayCalendar) IsHoliday(t time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	observed, ok := c.years[t.Year()]
+	if !ok {
+		observed = make(map[string]bool)
+		for _, set := range c.sets {
+			for _, h := range set.Holidays(t.Year()) {
+				observed[h.Date.Format(time.DateOnly)] = true
+			}
+		}
+		c.years[t.Year()] = observed
+	}
+	return observed[t.Format(time.DateOnly)]
+}
+
+// localDate moves a civil date from midnight UTC to midnight local time
+func localDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+}
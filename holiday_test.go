@@ -0,0 +1,78 @@
+package DateTimeMate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadHolidayCalendar(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "company.json")
+	if err := os.WriteFile(path, []byte(`[{"name": "Company Day", "date": "2026-08-14"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cal, err := LoadHolidayCalendar("us", path, "2026-12-24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	holidays := cal.Holidays(2026)
+	if len(holidays) != 13 {
+		t.Fatalf("got %d holidays, want 11 US plus 2 more: %v", len(holidays), holidays)
+	}
+	for i := 1; i < len(holidays); i++ {
+		if holidays[i].Date.Before(holidays[i-1].Date) {
+			t.Errorf("holidays out of order: %v before %v", holidays[i-1].Date, holidays[i].Date)
+		}
+	}
+	for _, h := range holidays {
+		if h.Name == "Independence Day" {
+			if !h.Observed() || h.Date.Format(time.DateOnly) != "2026-07-03" || h.Set != "US" {
+				t.Errorf("Independence Day: %+v", h)
+			}
+		}
+		if h.Date.Location() != time.Local {
+			t.Errorf("%s: date in %v, want local time", h.Name, h.Date.Location())
+		}
+	}
+
+	for _, tt := range []struct {
+		date string
+		want bool
+	}{
+		{"2026-07-03", true}, // observed
+		{"2026-07-04", false},
+		{"2026-08-14", true},
+		{"2026-12-24", true},
+		{"2026-12-23", false},
+		{"2021-12-31", true}, // New Year's Day 2022 observed
+	} {
+		d, _ := time.Parse(time.DateOnly, tt.date)
+		if got := cal.IsHoliday(d.Add(15 * time.Hour)); got != tt.want {
+			t.Errorf("IsHoliday(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestLoadHolidayCalendarInvalid(t *testing.T) {
+	t.Parallel()
+	if _, err := LoadHolidayCalendar("XX"); err == nil || !strings.Contains(err.Error(), "US") {
+		t.Errorf("expected an error listing the built-in sets, got: %v", err)
+	}
+	if _, err := LoadHolidayCalendar(filepath.Join(t.TempDir(), "missing.ics")); err == nil {
+		t.Error("expected an error for a missing holiday file, got nil")
+	}
+}
+
+func TestDurBusinessDaysHolidayCalendar(t *testing.T) {
+	t.Parallel()
+	cal, err := LoadHolidayCalendar("US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Wednesday before Thanksgiving
+	testBusinessDays(t, []OptionsDur{DurWithFrom("2026-11-25"), DurWithDur("2B"), DurWithHolidays(cal)},
+		[]string{"2026-11-30 Mon"}, []string{"2026-11-23 Mon"})
+}
@@ -0,0 +1,102 @@
+// Package config reads dtmate's configuration file: "key = value" lines,
+// full-line "#" or ";" comments, and "[section]" headers that prefix the
+// keys after them, so "dur = %F %T" under "[format]" is the key
+// "format.dur". A value may be quoted with matching double or single
+// quotes to keep leading or trailing spaces.
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Entry is a value and the line of the file it was read from
+type Entry struct {
+	Value string
+	Line  int
+}
+
+// File holds the entries of the configuration file at Path
+type File struct {
+	Path    string
+	Entries map[string]Entry
+}
+
+// Parse reads the entries of a configuration file; keys are lowercase and a
+// key given twice is an error, so a stale duplicate cannot silently win
+func Parse(r io.Reader) (map[string]Entry, error) {
+	entries := make(map[string]Entry)
+	section := ""
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") || strings.TrimSpace(text[1:len(text)-1]) == "" {
+				return nil, fmt.Errorf("line %d: invalid section header %q", line, text)
+			}
+			section = strings.ToLower(strings.TrimSpace(text[1:len(text)-1])) + "."
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected key = value, not %q", line, text)
+		}
+		key = section + key
+		if previous, ok := entries[key]; ok {
+			return nil, fmt.Errorf("line %d: %s is already set on line %d", line, key, previous.Line)
+		}
+		entries[key] = Entry{Value: unquote(strings.TrimSpace(value)), Line: line}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// unquote removes one pair of matching surrounding quotes
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Load reads the configuration file at path; a missing file is returned as
+// an error wrapping fs.ErrNotExist, so callers can treat a missing default
+// file as empty
+func Load(path string) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entries, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &File{Path: path, Entries: entries}, nil
+}
+
+// DefaultPath returns the XDG location of the configuration file,
+// $XDG_CONFIG_HOME/dtmate/config, or $HOME/.config/dtmate/config when
+// XDG_CONFIG_HOME is unset or not absolute
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if !filepath.IsAbs(dir) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", errors.New("neither $XDG_CONFIG_HOME nor $HOME is set")
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "dtmate", "config"), nil
+}
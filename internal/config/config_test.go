@@ -0,0 +1,85 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	entries, err := Parse(strings.NewReader(`
+# dtmate settings
+Date-Order = DMY
+brief=true
+; comment
+
+[Format]
+dur = "%F %T "
+week = '%a %F'
+fmt = %s
+`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := map[string]Entry{
+		"date-order":  {"DMY", 3},
+		"brief":       {"true", 4},
+		"format.dur":  {"%F %T ", 8},
+		"format.week": {"%a %F", 9},
+		"format.fmt":  {"%s", 10},
+	}
+	if len(entries) != len(want) {
+		t.Errorf("Parse = %v, want %v", entries, want)
+	}
+	for key, entry := range want {
+		if entries[key] != entry {
+			t.Errorf("Parse[%q] = %v, want %v", key, entries[key], entry)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	for _, text := range []string{
+		"date-order",
+		"= DMY",
+		"[format",
+		"[]",
+		"brief = true\nbrief = false",
+	} {
+		if _, err := Parse(strings.NewReader(text)); err == nil {
+			t.Errorf("Parse(%q): want error", text)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	if err := os.WriteFile(path, []byte("brief = yes\nbrief = no\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), path) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Load: err = %v, want the path and line", err)
+	}
+	if _, err := Load(filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Load(missing): err = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	if path, err := DefaultPath(); err != nil || path != filepath.Join("/xdg", "dtmate", "config") {
+		t.Errorf("DefaultPath = %q, %v", path, err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", "relative")
+	t.Setenv("HOME", "/home/someone")
+	if path, err := DefaultPath(); err != nil || path != filepath.Join("/home/someone", ".config", "dtmate", "config") {
+		t.Errorf("DefaultPath = %q, %v", path, err)
+	}
+}
@@ -0,0 +1,332 @@
+// Package cron parses and evaluates cron expressions: the classic 5-field
+// form (minute hour day-of-month month day-of-week), a 6-field form with a
+// leading seconds field, and the @yearly, @monthly, @weekly, @daily, and
+// @hourly macros. Fields take lists, ranges, steps, and month and weekday
+// names, plus the Quartz extensions L (last day), L-n, nW (nearest
+// weekday), and LW in the day-of-month field and nL (last weekday n) and
+// n#k (kth weekday n) in the day-of-week field. As in Vixie cron, a job
+// restricting both the day of the month and the day of the week fires on
+// days matching either.
+package cron
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// macros maps the supported @ macros to their 5-field expressions
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var weekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// bounds describes one field's permitted values
+type bounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	secondBounds  = bounds{"second", 0, 59, nil}
+	minuteBounds  = bounds{"minute", 0, 59, nil}
+	hourBounds    = bounds{"hour", 0, 23, nil}
+	domBounds     = bounds{"day-of-month", 1, 31, nil}
+	monthBounds   = bounds{"month", 1, 12, monthNames}
+	weekdayBounds = bounds{"day-of-week", 0, 7, weekdayNames}
+)
+
+// field is one parsed field: the set of plain values, and whether the
+// field is unrestricted because it starts with * or ?
+type field struct {
+	raw  string
+	set  uint64
+	star bool
+}
+
+// has reports whether n is in the field's set
+func (f field) has(n int) bool {
+	return f.set&(1<<uint(n)) != 0
+}
+
+// values returns the field's set in ascending order
+func (f field) values() []int {
+	var list []int
+	for set := f.set; set != 0; set &= set - 1 {
+		list = append(list, bits.TrailingZeros64(set))
+	}
+	return list
+}
+
+// domKind selects a day-of-month extension
+type domKind int
+
+const (
+	domLast        domKind = iota // L or L-n: n days before the last day
+	domNearest                    // nW: the weekday nearest day n
+	domLastWeekday                // LW: the last weekday of the month
+)
+
+// domRule is one day-of-month extension
+type domRule struct {
+	kind domKind
+	n    int
+}
+
+// weekdayRule is one day-of-week extension: the nth weekday of the month,
+// where nth=-1 is the last
+type weekdayRule struct {
+	weekday time.Weekday
+	nth     int
+}
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	expr         string
+	hasSeconds   bool
+	second       field
+	minute       field
+	hour         field
+	dom          field
+	month        field
+	dow          field
+	domRules     []domRule
+	weekdayRules []weekdayRule
+}
+
+// String returns the expression as it was given
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Parse parses a 5- or 6-field cron expression or an @ macro; names and
+// the L and W letters are case-insensitive
+func Parse(expr string) (*Schedule, error) {
+	s := &Schedule{expr: strings.TrimSpace(expr)}
+	text := s.expr
+	if strings.HasPrefix(text, "@") {
+		expanded, ok := macros[strings.ToLower(text)]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown macro; expected @yearly, @annually, @monthly, @weekly, @daily, @midnight, or @hourly", expr)
+		}
+		text = expanded
+	}
+	fields := strings.Fields(strings.ToUpper(text))
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+		s.hasSeconds = true
+	default:
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour day-of-month month day-of-week) or 6 with leading seconds, got %d", expr, len(fields))
+	}
+	for _, f := range []struct {
+		dst *field
+		raw string
+		b   bounds
+	}{
+		{&s.second, fields[0], secondBounds},
+		{&s.minute, fields[1], minuteBounds},
+		{&s.hour, fields[2], hourBounds},
+		{&s.month, fields[4], monthBounds},
+	} {
+		var err error
+		if *f.dst, err = parseField(f.raw, f.b); err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+	}
+	if err := s.parseDayOfMonth(fields[3]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	if err := s.parseDayOfWeek(fields[5]); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	return s, nil
+}
+
+// parseField parses a comma-separated list of *, values, ranges, and
+// steps
+func parseField(raw string, b bounds) (field, error) {
+	f := field{raw: raw, star: strings.HasPrefix(raw, "*")}
+	for _, item := range strings.Split(raw, ",") {
+		if err := f.addItem(item, b); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+// addItem adds one list item: *, n, a-b, or any of those with /step
+func (f *field) addItem(item string, b bounds) error {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+			return fmt.Errorf("invalid %s step %q", b.name, item)
+		}
+	}
+	var lo, hi int
+	switch {
+	case rangePart == "*":
+		lo, hi = b.min, b.max
+		if b.max == 7 {
+			hi = 6 // Sunday is 0; 7 is only an alias
+		}
+	case strings.Contains(rangePart, "-"):
+		first, last, _ := strings.Cut(rangePart, "-")
+		var err error
+		if lo, err = parseValue(first, b); err != nil {
+			return err
+		}
+		if hi, err = parseValue(last, b); err != nil {
+			return err
+		}
+		if b.max == 7 && hi == 0 && lo > 0 {
+			hi = 7 // MON-SUN
+		}
+		if lo > hi {
+			return fmt.Errorf("invalid %s range %q: the start must not exceed the end", b.name, item)
+		}
+	default:
+		var err error
+		if lo, err = parseValue(rangePart, b); err != nil {
+			return err
+		}
+		hi = lo
+		if hasStep {
+			hi = b.max
+		}
+	}
+	for n := lo; n <= hi; n += step {
+		v := n
+		if b.max == 7 {
+			v = n % 7 // day-of-week 7 is Sunday, stored as 0
+		}
+		f.set |= 1 << uint(v)
+	}
+	return nil
+}
+
+// parseValue parses a number or name within the field's bounds
+func parseValue(s string, b bounds) (int, error) {
+	if n, ok := b.names[s]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < b.min || n > b.max {
+		if b.names != nil {
+			return 0, fmt.Errorf("invalid %s %q: expected %d-%d or a name such as %s", b.name, s, b.min, b.max, exampleName(b))
+		}
+		return 0, fmt.Errorf("invalid %s %q: expected %d-%d", b.name, s, b.min, b.max)
+	}
+	return n, nil
+}
+
+// exampleName returns a name the field accepts, for error messages
+func exampleName(b bounds) string {
+	if b.max == 7 {
+		return "MON"
+	}
+	return "JAN"
+}
+
+// parseDayOfMonth parses the day-of-month field with its L, L-n, nW, and
+// LW extensions
+func (s *Schedule) parseDayOfMonth(raw string) error {
+	var plain []string
+	for _, item := range strings.Split(raw, ",") {
+		switch {
+		case item == "L":
+			s.domRules = append(s.domRules, domRule{kind: domLast})
+		case item == "LW":
+			s.domRules = append(s.domRules, domRule{kind: domLastWeekday})
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 1 || n > 30 {
+				return fmt.Errorf("invalid day-of-month %q: expected L-1 to L-30", item)
+			}
+			s.domRules = append(s.domRules, domRule{kind: domLast, n: n})
+		case strings.HasSuffix(item, "W"):
+			n, err := parseValue(strings.TrimSuffix(item, "W"), domBounds)
+			if err != nil {
+				return fmt.Errorf("invalid day-of-month %q: expected 1W to 31W", item)
+			}
+			s.domRules = append(s.domRules, domRule{kind: domNearest, n: n})
+		default:
+			plain = append(plain, item)
+		}
+	}
+	if raw == "?" {
+		s.dom, _ = parseField("*", domBounds)
+		s.dom.raw = raw
+		return nil
+	}
+	s.dom = field{raw: raw, star: strings.HasPrefix(raw, "*")}
+	for _, item := range plain {
+		if err := s.dom.addItem(item, domBounds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDayOfWeek parses the day-of-week field with its nL and n#k
+// extensions; a lone L means Saturday, the last day of the week
+func (s *Schedule) parseDayOfWeek(raw string) error {
+	var plain []string
+	for _, item := range strings.Split(raw, ",") {
+		switch {
+		case item == "L":
+			plain = append(plain, "6")
+		case strings.HasSuffix(item, "L"):
+			day, err := parseValue(strings.TrimSuffix(item, "L"), weekdayBounds)
+			if err != nil {
+				return fmt.Errorf("invalid day-of-week %q: expected a weekday followed by L, such as 5L or FRIL", item)
+			}
+			s.weekdayRules = append(s.weekdayRules, weekdayRule{weekday: time.Weekday(day % 7), nth: -1})
+		case strings.Contains(item, "#"):
+			dayPart, nthPart, _ := strings.Cut(item, "#")
+			day, err := parseValue(dayPart, weekdayBounds)
+			if err != nil {
+				return err
+			}
+			nth, err := strconv.Atoi(nthPart)
+			if err != nil || nth < 1 || nth > 5 {
+				return fmt.Errorf("invalid day-of-week %q: the number after # must be 1 to 5", item)
+			}
+			s.weekdayRules = append(s.weekdayRules, weekdayRule{weekday: time.Weekday(day % 7), nth: nth})
+		default:
+			plain = append(plain, item)
+		}
+	}
+	if raw == "?" {
+		s.dow, _ = parseField("*", weekdayBounds)
+		s.dow.raw = raw
+		return nil
+	}
+	s.dow = field{raw: raw, star: strings.HasPrefix(raw, "*")}
+	for _, item := range plain {
+		if err := s.dow.addItem(item, weekdayBounds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
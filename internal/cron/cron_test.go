@@ -0,0 +1,143 @@
+package cron
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimes(t *testing.T) {
+	t.Parallel()
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		expr     string
+		from     string
+		loc      *time.Location
+		count    int
+		backward bool
+		want     []string
+	}{
+		{"office hours", "*/15 9-17 * * MON-FRI", "2026-10-16 17:50:00", berlin, 3, false,
+			[]string{"2026-10-19 09:00:00 CEST", "2026-10-19 09:15:00 CEST", "2026-10-19 09:30:00 CEST"}},
+		{"office hours backward", "*/15 9-17 * * MON-FRI", "2026-10-19 09:00:00", berlin, 2, true,
+			[]string{"2026-10-16 17:45:00 CEST", "2026-10-16 17:30:00 CEST"}},
+		{"six fields", "*/20 * * * * *", "2026-10-18 12:00:00", ny, 3, false,
+			[]string{"2026-10-18 12:00:20 EDT", "2026-10-18 12:00:40 EDT", "2026-10-18 12:01:00 EDT"}},
+		{"weekly macro", "@weekly", "2026-10-18 10:00:00", ny, 1, false, []string{"2026-10-25 00:00:00 EDT"}},
+		{"yearly macro", "@YEARLY", "2026-10-18 10:00:00", ny, 1, false, []string{"2027-01-01 00:00:00 EST"}},
+		{"sunday as 7", "0 0 * * 7", "2026-10-18 10:00:00", ny, 1, false, []string{"2026-10-25 00:00:00 EDT"}},
+		{"leap day", "0 0 29 2 *", "2026-01-01 00:00:00", ny, 1, false, []string{"2028-02-29 00:00:00 EST"}},
+		{"either day field", "0 0 13 * 5", "2026-11-01 00:00:00", ny, 3, false,
+			[]string{"2026-11-06 00:00:00 EST", "2026-11-13 00:00:00 EST", "2026-11-20 00:00:00 EST"}},
+		{"day field with star", "0 0 */10 * MON", "2026-11-01 00:00:00", ny, 2, false,
+			[]string{"2026-12-21 00:00:00 EST", "2027-01-11 00:00:00 EST"}},
+
+		{"last day", "0 0 L * *", "2026-01-15 00:00:00", ny, 3, false,
+			[]string{"2026-01-31 00:00:00 EST", "2026-02-28 00:00:00 EST", "2026-03-31 00:00:00 EDT"}},
+		{"before the last day", "0 0 L-2 * *", "2026-02-01 00:00:00", ny, 1, false, []string{"2026-02-26 00:00:00 EST"}},
+		{"nearest weekday", "0 9 15W * *", "2026-08-01 00:00:00", ny, 3, false,
+			[]string{"2026-08-14 09:00:00 EDT", "2026-09-15 09:00:00 EDT", "2026-10-15 09:00:00 EDT"}},
+		{"nearest weekday stays in the month", "0 9 1W * *", "2026-08-01 00:00:00", ny, 1, false, []string{"2026-08-03 09:00:00 EDT"}},
+		{"last weekday", "0 18 LW * *", "2026-10-01 00:00:00", ny, 2, false,
+			[]string{"2026-10-30 18:00:00 EDT", "2026-11-30 18:00:00 EST"}},
+		{"last friday", "0 17 * * 5L", "2026-10-01 00:00:00", ny, 2, false,
+			[]string{"2026-10-30 17:00:00 EDT", "2026-11-27 17:00:00 EST"}},
+		{"first tuesday", "0 10 ? * TUE#1", "2026-10-01 00:00:00", ny, 2, false,
+			[]string{"2026-10-06 10:00:00 EDT", "2026-11-03 10:00:00 EST"}},
+
+		{"fixed time in a gap runs when it ends", "30 2 * * *", "2026-03-07 00:00:00", ny, 3, false,
+			[]string{"2026-03-07 02:30:00 EST", "2026-03-08 03:00:00 EDT", "2026-03-09 02:30:00 EDT"}},
+		{"wildcard times in a gap are skipped", "*/30 * * * *", "2026-03-08 01:00:00", ny, 3, false,
+			[]string{"2026-03-08 01:30:00 EST", "2026-03-08 03:00:00 EDT", "2026-03-08 03:30:00 EDT"}},
+		{"fixed time in an overlap runs once", "30 1 * * *", "2026-10-31 12:00:00", ny, 2, false,
+			[]string{"2026-11-01 01:30:00 EDT", "2026-11-02 01:30:00 EST"}},
+		{"wildcard hour in an overlap runs twice", "0 * * * *", "2026-11-01 00:30:00", ny, 3, false,
+			[]string{"2026-11-01 01:00:00 EDT", "2026-11-01 01:00:00 EST", "2026-11-01 02:00:00 EST"}},
+		{"wildcard minute in an overlap runs twice", "*/30 1 * * *", "2026-11-01 00:00:00", ny, 4, false,
+			[]string{"2026-11-01 01:00:00 EDT", "2026-11-01 01:30:00 EDT", "2026-11-01 01:00:00 EST", "2026-11-01 01:30:00 EST"}},
+		{"overlap backward", "30 1 * * *", "2026-11-02 00:00:00", ny, 1, true, []string{"2026-11-01 01:30:00 EDT"}},
+		{"gap in Berlin", "30 2 * * *", "2026-03-28 12:00:00", berlin, 1, false, []string{"2026-03-29 03:00:00 CEST"}},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		from, err := time.ParseInLocation(time.DateTime, tt.from, tt.loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		times, err := s.Times(from, tt.count, tt.backward)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		var got []string
+		for _, run := range times {
+			got = append(got, run.Format("2006-01-02 15:04:05 MST"))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s:\n got %v\nwant %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	t.Parallel()
+	s, err := Parse("0 12 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	noon := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	next, err := s.Next(noon)
+	if err != nil || !next.Equal(noon.AddDate(0, 0, 1)) {
+		t.Errorf("Next(%s) = %s, %v", noon, next, err)
+	}
+	prev, err := s.Prev(noon)
+	if err != nil || !prev.Equal(noon.AddDate(0, 0, -1)) {
+		t.Errorf("Prev(%s) = %s, %v", noon, prev, err)
+	}
+	never, _ := Parse("0 0 30 2 *")
+	if _, err := never.Next(noon); !errors.Is(err, ErrNeverFires) {
+		t.Errorf("Next for Feb 30: error = %v, want ErrNeverFires", err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"* * * *":           "expected 5 fields",
+		"* * * * * * *":     "expected 5 fields",
+		"@reboot":           "unknown macro",
+		"60 * * * *":        "invalid minute",
+		"* 24 * * *":        "invalid hour",
+		"* * 0 * *":         "invalid day-of-month",
+		"* * * 13 *":        "invalid month",
+		"* * * FOO *":       "invalid month",
+		"* * * * 8":         "invalid day-of-week",
+		"*/0 * * * *":       "invalid minute step",
+		"30-10 * * * *":     "must not exceed",
+		"? * * * *":         "invalid minute",
+		"0 0 L-31 * *":      "L-1 to L-30",
+		"0 0 32W * *":       "1W to 31W",
+		"0 0 * * MON#6":     "1 to 5",
+		"0 0 * * XL":        "followed by L",
+		"0 0 * * FRI#first": "1 to 5",
+	}
+	for expr, want := range tests {
+		if _, err := Parse(expr); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) error = %v, want it to mention %q", expr, err, want)
+		}
+	}
+}
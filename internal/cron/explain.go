@@ -0,0 +1,375 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxListedTimes is the most times of day Explain lists individually, as
+// in "at 09:00 and 17:30", before describing the fields separately
+const maxListedTimes = 8
+
+// Explain renders the schedule in plain English, such as "Every 15
+// minutes, between 09:00 and 17:59, on Monday through Friday"
+func (s *Schedule) Explain() string {
+	var parts []string
+	for _, part := range append(s.explainTime(), s.explainDays(), s.explainMonths()) {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	text := strings.Join(parts, ", ")
+	return strings.ToUpper(text[:1]) + text[1:]
+}
+
+// explainTime describes the second, minute, and hour fields
+func (s *Schedule) explainTime() []string {
+	if clock := s.explainClock(); clock != "" {
+		return []string{clock}
+	}
+	var parts []string
+	if s.hasSeconds && s.second.raw != "0" {
+		parts = append(parts, describe(s.second.raw, secondBounds, clockPhrases("second")))
+	}
+	minutes := describe(s.minute.raw, minuteBounds, clockPhrases("minute"))
+	if s.minute.raw == "*" && len(parts) > 0 {
+		minutes = ""
+	}
+	if s.hour.raw == "*" && isSingles(s.minute.raw) {
+		minutes += " of every hour"
+	}
+	parts = append(parts, minutes)
+	return append(parts, describe(s.hour.raw, hourBounds, hourPhrases))
+}
+
+// explainClock lists the times of day, such as "at 09:00 and 17:30", when
+// the minute and hour (and any seconds) are plain values yielding only a
+// few times; otherwise it returns ""
+func (s *Schedule) explainClock() string {
+	if !isSingles(s.minute.raw) || !isSingles(s.hour.raw) || (s.hasSeconds && !isSingles(s.second.raw)) {
+		return ""
+	}
+	hours, minutes, seconds := s.hour.values(), s.minute.values(), s.second.values()
+	if len(hours)*len(minutes)*len(seconds) > maxListedTimes {
+		return ""
+	}
+	showSeconds := len(seconds) > 1 || seconds[0] != 0
+	var times []string
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, sec := range seconds {
+				clock := fmt.Sprintf("%02d:%02d", h, m)
+				if showSeconds {
+					clock += fmt.Sprintf(":%02d", sec)
+				}
+				times = append(times, clock)
+			}
+		}
+	}
+	return "at " + joinList(times)
+}
+
+// explainDays describes the day-of-month and day-of-week fields; when both
+// are restricted, a day matching either qualifies
+func (s *Schedule) explainDays() string {
+	dom := s.explainDayOfMonth()
+	dow := s.explainDayOfWeek()
+	switch {
+	case dom != "" && dow != "" && !s.dom.star && !s.dow.star:
+		return dom + " or " + dow
+	case dom != "" && dow != "":
+		return dom + ", " + dow
+	}
+	return dom + dow
+}
+
+// explainDayOfMonth describes the day-of-month field and its extensions
+func (s *Schedule) explainDayOfMonth() string {
+	var plain []string
+	var phrases []string
+	for _, item := range strings.Split(s.dom.raw, ",") {
+		switch {
+		case item == "?":
+		case item == "L":
+			phrases = append(phrases, "on the last day of the month")
+		case item == "LW":
+			phrases = append(phrases, "on the last weekday of the month")
+		case strings.HasPrefix(item, "L-"):
+			n, _ := strconv.Atoi(item[2:])
+			phrases = append(phrases, plural(n, "day")+" before the last day of the month")
+		case strings.HasSuffix(item, "W"):
+			n, _ := strconv.Atoi(strings.TrimSuffix(item, "W"))
+			phrases = append(phrases, "on the weekday nearest the "+ordinal(n)+" of the month")
+		default:
+			plain = append(plain, item)
+		}
+	}
+	if len(plain) > 0 {
+		phrases = append([]string{describe(strings.Join(plain, ","), domBounds, domPhrases)}, phrases...)
+	}
+	return joinNonEmpty(phrases)
+}
+
+// explainDayOfWeek describes the day-of-week field and its extensions
+func (s *Schedule) explainDayOfWeek() string {
+	var plain []string
+	var phrases []string
+	for _, item := range strings.Split(s.dow.raw, ",") {
+		switch {
+		case item == "?":
+		case item == "L":
+			plain = append(plain, "6")
+		case strings.HasSuffix(item, "L"):
+			day, _ := parseValue(strings.TrimSuffix(item, "L"), weekdayBounds)
+			phrases = append(phrases, "on the last "+weekdayName(day)+" of the month")
+		case strings.Contains(item, "#"):
+			dayPart, nthPart, _ := strings.Cut(item, "#")
+			day, _ := parseValue(dayPart, weekdayBounds)
+			nth, _ := strconv.Atoi(nthPart)
+			phrases = append(phrases, "on the "+nthWords[nth]+" "+weekdayName(day)+" of the month")
+		default:
+			plain = append(plain, item)
+		}
+	}
+	if len(plain) > 0 {
+		phrases = append([]string{describe(strings.Join(plain, ","), weekdayBounds, weekdayPhrases)}, phrases...)
+	}
+	return joinNonEmpty(phrases)
+}
+
+// explainMonths describes the month field
+func (s *Schedule) explainMonths() string {
+	return describe(s.month.raw, monthBounds, monthPhrases)
+}
+
+// phrases renders one field's list items: all is the phrase for a bare *,
+// single a group of plain values, span a range, and every a step, where
+// lo is -1 for a step over the whole field and hi is -1 for a step from a
+// start to the field's end
+type phrases struct {
+	all    string
+	single func(values []int) string
+	span   func(lo, hi int) string
+	every  func(step, lo, hi int) string
+}
+
+// describe renders a field's comma-separated items with p; plain values
+// are gathered into one phrase placed first
+func describe(raw string, b bounds, p phrases) string {
+	var singles []int
+	var list []string
+	for _, item := range strings.Split(raw, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step, _ := strconv.Atoi(stepPart)
+		switch {
+		case rangePart == "*" && !hasStep:
+			list = append(list, p.all)
+		case rangePart == "*":
+			list = append(list, p.every(step, -1, -1))
+		case strings.Contains(rangePart, "-"):
+			first, last, _ := strings.Cut(rangePart, "-")
+			lo, _ := parseValue(first, b)
+			hi, _ := parseValue(last, b)
+			if hasStep {
+				list = append(list, p.every(step, lo, hi))
+			} else {
+				list = append(list, p.span(lo, hi))
+			}
+		default:
+			n, _ := parseValue(rangePart, b)
+			if hasStep {
+				list = append(list, p.every(step, n, -1))
+			} else {
+				singles = append(singles, n)
+			}
+		}
+	}
+	if len(singles) > 0 {
+		list = append([]string{p.single(singles)}, list...)
+	}
+	return joinNonEmpty(list)
+}
+
+// clockPhrases returns the phrases for the second or minute field
+func clockPhrases(unit string) phrases {
+	return phrases{
+		all: "every " + unit,
+		single: func(values []int) string {
+			if len(values) == 1 {
+				return fmt.Sprintf("at %s %d", unit, values[0])
+			}
+			return fmt.Sprintf("at %ss %s", unit, joinList(formatInts(values, "%d")))
+		},
+		span: func(lo, hi int) string {
+			return fmt.Sprintf("every %s from %s %d through %d", unit, unit, lo, hi)
+		},
+		every: func(step, lo, hi int) string {
+			switch {
+			case lo < 0:
+				return "every " + plural(step, unit)
+			case hi < 0:
+				return fmt.Sprintf("every %s starting at %s %d", plural(step, unit), unit, lo)
+			}
+			return fmt.Sprintf("every %s from %s %d through %d", plural(step, unit), unit, lo, hi)
+		},
+	}
+}
+
+var hourPhrases = phrases{
+	single: func(values []int) string {
+		if len(values) == 1 {
+			return fmt.Sprintf("between %02d:00 and %02d:59", values[0], values[0])
+		}
+		return "during the " + joinList(formatInts(values, "%02d:00")) + " hours"
+	},
+	span: func(lo, hi int) string {
+		return fmt.Sprintf("between %02d:00 and %02d:59", lo, hi)
+	},
+	every: func(step, lo, hi int) string {
+		switch {
+		case lo < 0:
+			return "every " + plural(step, "hour")
+		case hi < 0:
+			return fmt.Sprintf("every %s starting at %02d:00", plural(step, "hour"), lo)
+		}
+		return fmt.Sprintf("every %s from %02d:00 through %02d:00", plural(step, "hour"), lo, hi)
+	},
+}
+
+var domPhrases = phrases{
+	single: func(values []int) string {
+		var days []string
+		for _, n := range values {
+			days = append(days, ordinal(n))
+		}
+		return "on the " + joinList(days) + " of the month"
+	},
+	span: func(lo, hi int) string {
+		return fmt.Sprintf("on the %s through %s of the month", ordinal(lo), ordinal(hi))
+	},
+	every: func(step, lo, hi int) string {
+		switch {
+		case lo < 0:
+			return "every " + plural(step, "day")
+		case hi < 0:
+			return fmt.Sprintf("every %s starting on the %s", plural(step, "day"), ordinal(lo))
+		}
+		return fmt.Sprintf("every %s from the %s through %s", plural(step, "day"), ordinal(lo), ordinal(hi))
+	},
+}
+
+var weekdayPhrases = phrases{
+	single: func(values []int) string {
+		var days []string
+		for _, n := range values {
+			days = append(days, weekdayName(n))
+		}
+		return "on " + joinList(days)
+	},
+	span: func(lo, hi int) string {
+		return fmt.Sprintf("on %s through %s", weekdayName(lo), weekdayName(hi))
+	},
+	every: func(step, lo, hi int) string {
+		switch {
+		case lo < 0:
+			return fmt.Sprintf("every %s day of the week", ordinal(step))
+		case hi < 0:
+			return fmt.Sprintf("every %s day of the week starting on %s", ordinal(step), weekdayName(lo))
+		}
+		return fmt.Sprintf("every %s day of the week from %s through %s", ordinal(step), weekdayName(lo), weekdayName(hi))
+	},
+}
+
+var monthPhrases = phrases{
+	single: func(values []int) string {
+		var months []string
+		for _, n := range values {
+			months = append(months, time.Month(n).String())
+		}
+		return "in " + joinList(months)
+	},
+	span: func(lo, hi int) string {
+		return fmt.Sprintf("in %s through %s", time.Month(lo), time.Month(hi))
+	},
+	every: func(step, lo, hi int) string {
+		switch {
+		case lo < 0:
+			return "every " + plural(step, "month")
+		case hi < 0:
+			return fmt.Sprintf("every %s starting in %s", plural(step, "month"), time.Month(lo))
+		}
+		return fmt.Sprintf("every %s from %s through %s", plural(step, "month"), time.Month(lo), time.Month(hi))
+	},
+}
+
+// nthWords names the n#k ordinals
+var nthWords = []string{"", "first", "second", "third", "fourth", "fifth"}
+
+// isSingles reports whether a numeric field lists only plain values
+func isSingles(raw string) bool {
+	return raw != "" && strings.Trim(raw, "0123456789,") == ""
+}
+
+// weekdayName names a day-of-week value, where 7 is Sunday
+func weekdayName(n int) string {
+	return time.Weekday(n % 7).String()
+}
+
+// ordinal returns n with its English ordinal suffix, such as 1st or 22nd
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+// plural returns "unit" for 1 and "n units" otherwise
+func plural(n int, unit string) string {
+	if n == 1 {
+		return unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// formatInts formats each value with format
+func formatInts(values []int, format string) []string {
+	var list []string
+	for _, n := range values {
+		list = append(list, fmt.Sprintf(format, n))
+	}
+	return list
+}
+
+// joinNonEmpty joins the non-empty phrases as an English list
+func joinNonEmpty(list []string) string {
+	var kept []string
+	for _, s := range list {
+		if s != "" {
+			kept = append(kept, s)
+		}
+	}
+	return joinList(kept)
+}
+
+// joinList joins items as an English list: "a", "a and b", or
+// "a, b, and c"
+func joinList(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " and " + items[1]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + ", and " + items[len(items)-1]
+}
@@ -0,0 +1,38 @@
+package cron
+
+import "testing"
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"* * * * *":             "Every minute",
+		"*/15 9-17 * * MON-FRI": "Every 15 minutes, between 09:00 and 17:59, on Monday through Friday",
+		"0 9 * * *":             "At 09:00",
+		"@hourly":               "At minute 0 of every hour",
+		"0 */2 * * *":           "At minute 0, every 2 hours",
+		"30 9,17 1,15 * *":      "At 09:30 and 17:30, on the 1st and 15th of the month",
+		"0-29 9 * * *":          "Every minute from minute 0 through 29, between 09:00 and 09:59",
+		"5 4 * JAN,JUL SUN":     "At 04:05, on Sunday, in January and July",
+		"0 0 1 */3 *":           "At 00:00, on the 1st of the month, every 3 months",
+		"0 0 13 * FRI":          "At 00:00, on the 13th of the month or on Friday",
+		"0 0 L * *":             "At 00:00, on the last day of the month",
+		"0 0 L-3 * *":           "At 00:00, 3 days before the last day of the month",
+		"0 9 15W * *":           "At 09:00, on the weekday nearest the 15th of the month",
+		"0 18 LW * *":           "At 18:00, on the last weekday of the month",
+		"0 17 * * 5L":           "At 17:00, on the last Friday of the month",
+		"0 10 ? * 2#1":          "At 10:00, on the first Tuesday of the month",
+		"*/10 * * * * *":        "Every 10 seconds",
+		"30 0 12 * * *":         "At 12:00:30",
+		"0 0 0 * * MON-SUN":     "At 00:00, on Monday through Sunday",
+	}
+	for expr, want := range tests {
+		s, err := Parse(expr)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", expr, err)
+			continue
+		}
+		if got := s.Explain(); got != want {
+			t.Errorf("Explain(%q) = %q, want %q", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+)
+
+// ErrNeverFires is returned for an expression that matches no date, such
+// as "0 0 30 2 *"
+var ErrNeverFires = errors.New("cron expression never fires")
+
+// maxSearchDays bounds the consecutive days searched without a run; it
+// spans more than one 28-year cycle of the calendar, so any expression
+// that can match some date does so within it
+const maxSearchDays = 366 * 30
+
+// Next returns the first run strictly after t, in t's location
+func (s *Schedule) Next(t time.Time) (time.Time, error) {
+	runs, err := s.Times(t, 1, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return runs[0], nil
+}
+
+// Prev returns the last run strictly before t, in t's location
+func (s *Schedule) Prev(t time.Time) (time.Time, error) {
+	runs, err := s.Times(t, 1, true)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return runs[0], nil
+}
+
+// Times returns count runs strictly after from, or strictly before it
+// when backward is true, nearest first. Runs follow the wall clock of
+// from's location with Vixie cron's DST rules: a job whose minute and
+// hour are both fixed runs once when its time is repeated by a fall-back
+// change, and at the end of a spring-forward gap when its time is
+// skipped; a job with a wildcard minute or hour runs in both copies of a
+// repeated hour and not at all in a skipped one.
+func (s *Schedule) Times(from time.Time, count int, backward bool) ([]time.Time, error) {
+	loc := from.Location()
+	date := civilDate(from.In(loc))
+	step := 1
+	if backward {
+		step = -1
+	}
+	var runs []time.Time
+	for idle := 0; len(runs) < count; date = date.AddDate(0, 0, step) {
+		if date.Year() < 1 || date.Year() > 9999 || idle > maxSearchDays {
+			if len(runs) > 0 {
+				return runs, nil
+			}
+			return nil, fmt.Errorf("%w within %d years of %s: %s", ErrNeverFires, maxSearchDays/366, from.Format(time.DateOnly), s.expr)
+		}
+		idle++
+		if !s.matchDay(date) {
+			continue
+		}
+		day := s.day(date, loc)
+		if backward {
+			slices.Reverse(day)
+		}
+		for _, t := range day {
+			if (!backward && t.After(from)) || (backward && t.Before(from)) {
+				runs = append(runs, t)
+				idle = 0
+				if len(runs) == count {
+					break
+				}
+			}
+		}
+	}
+	return runs, nil
+}
+
+// matchDay reports whether the civil date matches the month, day-of-month,
+// and day-of-week fields; when both day fields are restricted, either may
+// match, and when either starts with * both must
+func (s *Schedule) matchDay(date time.Time) bool {
+	if !s.month.has(int(date.Month())) {
+		return false
+	}
+	domMatch := s.dom.has(date.Day()) || s.matchDomRules(date)
+	dowMatch := s.dow.has(int(date.Weekday())) || s.matchWeekdayRules(date)
+	if s.dom.star || s.dow.star {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// matchDomRules reports whether the date matches an L, L-n, nW, or LW
+// day-of-month extension
+func (s *Schedule) matchDomRules(date time.Time) bool {
+	last := datecalc.DaysIn(date.Year(), date.Month())
+	for _, rule := range s.domRules {
+		switch rule.kind {
+		case domLast:
+			if date.Day() == last-rule.n {
+				return true
+			}
+		case domNearest:
+			if rule.n <= last && date.Day() == nearestWeekday(date, rule.n, last) {
+				return true
+			}
+		case domLastWeekday:
+			if date.Day() == nearestWeekday(date, last, last) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nearestWeekday returns the weekday (Monday-Friday) nearest day n of
+// date's month without leaving the month: a Saturday moves to Friday
+// unless n is the 1st, and a Sunday moves to Monday unless n is the last
+// day
+func nearestWeekday(date time.Time, n, last int) int {
+	switch time.Date(date.Year(), date.Month(), n, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return n + 2
+		}
+		return n - 1
+	case time.Sunday:
+		if n == last {
+			return n - 2
+		}
+		return n + 1
+	}
+	return n
+}
+
+// matchWeekdayRules reports whether the date matches an nL or n#k
+// day-of-week extension
+func (s *Schedule) matchWeekdayRules(date time.Time) bool {
+	for _, rule := range s.weekdayRules {
+		if rule.weekday != date.Weekday() {
+			continue
+		}
+		if rule.nth == -1 && date.Day()+7 > datecalc.DaysIn(date.Year(), date.Month()) {
+			return true
+		}
+		if rule.nth > 0 && (date.Day()-1)/7+1 == rule.nth {
+			return true
+		}
+	}
+	return false
+}
+
+// fixedTime reports whether the job's minute and hour are both fixed,
+// which decides how it behaves across DST changes
+func (s *Schedule) fixedTime() bool {
+	return !s.minute.star && !s.hour.star
+}
+
+// day returns the runs on a civil date in loc, sorted
+func (s *Schedule) day(date time.Time, loc *time.Location) []time.Time {
+	// the offsets in effect well before and well after the date; when they
+	// agree, the date has no DST change and every wall time is one instant
+	_, before := date.Add(-26 * time.Hour).In(loc).Zone()
+	_, after := date.Add(50 * time.Hour).In(loc).Zone()
+	fixed := s.fixedTime()
+	var runs []time.Time
+	for _, h := range s.hour.values() {
+		for _, m := range s.minute.values() {
+			for _, sec := range s.second.values() {
+				wall := date.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second)
+				if before == after {
+					runs = append(runs, wall.Add(-time.Duration(before)*time.Second).In(loc))
+					continue
+				}
+				instants, gapEnd := resolve(wall, loc, before, after)
+				switch {
+				case len(instants) == 0 && fixed:
+					runs = append(runs, gapEnd)
+				case len(instants) == 2 && fixed:
+					runs = append(runs, instants[0])
+				default:
+					runs = append(runs, instants...)
+				}
+			}
+		}
+	}
+	slices.SortFunc(runs, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(runs, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+// resolve returns the instants, in order, showing the wall time (given as
+// a UTC time) in loc, where the offset before a DST change is before and
+// after it is after: two for a repeated time, one normally, and none for a
+// time in a spring-forward gap, in which case gapEnd is the instant the
+// gap ends
+func resolve(wall time.Time, loc *time.Location, before, after int) (instants []time.Time, gapEnd time.Time) {
+	for _, offset := range []int{before, after} {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, got := t.Zone(); got == offset && !slices.ContainsFunc(instants, t.Equal) {
+			instants = append(instants, t)
+		}
+	}
+	slices.SortFunc(instants, func(a, b time.Time) int { return a.Compare(b) })
+	if len(instants) == 0 {
+		gapEnd, _ = wall.Add(-time.Duration(before) * time.Second).In(loc).ZoneBounds()
+	}
+	return instants, gapEnd
+}
+
+// civilDate returns t's date as midnight UTC
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -1,8 +1,14 @@
 // Package datecalc applies a signed number of calendar or clock units to a
 // time.Time. It replaces the arithmetic portion of
-// github.com/golang-module/carbon: years, weeks, and days use the
-// calendar-aware, overflow-normalizing time.Time.AddDate (Feb 29 + 1 year =
-// Mar 1), while hours through nanoseconds use the absolute time.Time.Add.
+// github.com/golang-module/carbon: years, quarters, months, weeks, and days
+// are calendar-aware, while hours through nanoseconds use the absolute
+// time.Time.Add. Year, quarter, and month arithmetic follows an EndOfMonth
+// policy for a day of the month the target month lacks: Overflow normalizes
+// like time.Time.AddDate (Feb 29 + 1 year = Mar 1), Clamp pins to the last
+// day of the target month (Feb 29 + 1 year = Feb 28). AddBusinessDays counts
+// days through a caller-supplied business-day predicate, StartOf and EndOf
+// snap a time to the bounds of its minute through year, and WeekRule
+// numbers the weeks of a year under ISO 8601, US, or custom rules.
 package datecalc
 
 import (
@@ -11,6 +17,19 @@ import (
 	"time"
 )
 
+// EndOfMonth selects how year, quarter, and month arithmetic treats a day
+// of the month that does not exist in the target month.
+type EndOfMonth int
+
+const (
+	// Overflow normalizes the surplus days into the following month, the
+	// way time.Time.AddDate does: Jan 31 + 1 month = Mar 2 in a leap year.
+	Overflow EndOfMonth = iota
+	// Clamp pins the day to the last day of the target month:
+	// Jan 31 + 1 month = Feb 29 in a leap year.
+	Clamp
+)
+
 // clockUnits are the units applied as absolute durations via time.Time.Add.
 var clockUnits = map[string]time.Duration{
 	"hour":        time.Hour,
@@ -21,13 +40,28 @@ var clockUnits = map[string]time.Duration{
 	"nanosecond":  time.Nanosecond,
 }
 
-// Apply adds (sign=+1) or subtracts (sign=-1) n units to t. unit is one of:
-// year, week, day, hour, minute, second, millisecond, microsecond,
-// nanosecond (singular, lowercase).
+// monthUnits are the calendar units counted in whole months.
+var monthUnits = map[string]int{
+	"year":    12,
+	"quarter": 3,
+	"month":   1,
+}
+
+// Apply adds (sign=+1) or subtracts (sign=-1) n units to t with the
+// Overflow end-of-month policy. unit is one of: year, quarter, month, week,
+// day, hour, minute, second, millisecond, microsecond, nanosecond
+// (singular, lowercase).
 func Apply(t time.Time, unit string, n int, sign int) (time.Time, error) {
+	return ApplyWithPolicy(t, unit, n, sign, Overflow)
+}
+
+// ApplyWithPolicy is Apply with an explicit end-of-month policy for the
+// year, quarter, and month units; the policy has no effect on other units.
+func ApplyWithPolicy(t time.Time, unit string, n int, sign int, policy EndOfMonth) (time.Time, error) {
+	if months, ok := monthUnits[unit]; ok {
+		return AddMonths(t, sign*n*months, policy), nil
+	}
 	switch unit {
-	case "year":
-		return t.AddDate(sign*n, 0, 0), nil
 	case "week":
 		return t.AddDate(0, 0, sign*n*7), nil
 	case "day":
@@ -44,3 +78,59 @@ func Apply(t time.Time, unit string, n int, sign int) (time.Time, error) {
 	}
 	return t, fmt.Errorf("unknown unit: %q", unit)
 }
+
+// AddMonths adds a signed number of months to t, keeping the wall clock;
+// policy decides what happens when t's day of the month does not exist in
+// the target month.
+func AddMonths(t time.Time, months int, policy EndOfMonth) time.Time {
+	if policy != Clamp {
+		return t.AddDate(0, months, 0)
+	}
+	year, month, day := t.Date()
+	if last := DaysIn(year, month+time.Month(months)); day > last {
+		day = last
+	}
+	return time.Date(year, month+time.Month(months), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// maxNonBusinessRun bounds the consecutive non-business days
+// AddBusinessDays will step over before giving up, so a calendar whose
+// holidays cover every remaining day cannot loop forever
+const maxNonBusinessRun = 366
+
+// MaxBusinessDays bounds the business days AddBusinessDays will count, about
+// 3,800 years of five-day weeks, since each one is found by stepping a day
+// at a time
+const MaxBusinessDays = 1_000_000
+
+// AddBusinessDays adds (sign=+1) or subtracts (sign=-1) n business days to
+// t, keeping the wall clock: each step moves one calendar day and only days
+// for which isBusinessDay returns true are counted, so Friday + 1 business
+// day is Monday with a Saturday-Sunday weekend, and Saturday + 1 is also
+// Monday. Zero business days leaves t unchanged, and more than
+// MaxBusinessDays is an error.
+func AddBusinessDays(t time.Time, n int, sign int, isBusinessDay func(time.Time) bool) (time.Time, error) {
+	if n > MaxBusinessDays {
+		return t, fmt.Errorf("at most %d business days can be added or subtracted, not %d", MaxBusinessDays, n)
+	}
+	for counted, run := 0, 0; counted < n; {
+		t = t.AddDate(0, 0, sign)
+		if isBusinessDay(t) {
+			counted++
+			run = 0
+			continue
+		}
+		if run++; run > maxNonBusinessRun {
+			return t, fmt.Errorf("no business day within %d days of %s", maxNonBusinessRun, t.Format(time.DateOnly))
+		}
+	}
+	return t, nil
+}
+
+// DaysIn returns the number of days in the given month; month values
+// outside 1-12 are normalized into the adjacent years the way time.Date
+// normalizes them.
+func DaysIn(year int, month time.Month) int {
+	// day 0 of the following month is the last day of this one
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
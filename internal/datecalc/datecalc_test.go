@@ -2,6 +2,7 @@ package datecalc
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -104,7 +105,118 @@ func TestApplyOverflowRejected(t *testing.T) {
 
 func TestApplyUnknownUnit(t *testing.T) {
 	t.Parallel()
-	if _, err := Apply(time.Now(), "month", 1, +1); err == nil {
+	if _, err := Apply(time.Now(), "fortnight", 1, +1); err == nil {
 		t.Error("expected an error for an unsupported unit, got nil")
 	}
 }
+
+func TestApplyMonths(t *testing.T) {
+	t.Parallel()
+	jan31 := time.Date(2024, 1, 31, 9, 15, 0, 0, time.UTC)
+	leapDay := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		t      time.Time
+		unit   string
+		n      int
+		sign   int
+		policy EndOfMonth
+		want   time.Time
+	}{
+		{"add month", time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), "month", 1, +1, Overflow, time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)},
+		{"sub month crosses year", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "month", 2, -1, Overflow, time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"jan 31 plus one month overflows into march", jan31, "month", 1, +1, Overflow, time.Date(2024, 3, 2, 9, 15, 0, 0, time.UTC)},
+		{"jan 31 plus one month clamps to feb 29", jan31, "month", 1, +1, Clamp, time.Date(2024, 2, 29, 9, 15, 0, 0, time.UTC)},
+		{"mar 31 minus one month clamps to feb 29", time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), "month", 1, -1, Clamp, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"add quarter", time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), "quarter", 1, +1, Clamp, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"sub two quarters", time.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC), "quarter", 2, -1, Overflow, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"leap day plus one year overflows", leapDay, "year", 1, +1, Overflow, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day plus one year clamps", leapDay, "year", 1, +1, Clamp, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"leap day plus four years is exact", leapDay, "year", 4, +1, Clamp, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"policy ignored for days", jan31, "day", 1, +1, Clamp, time.Date(2024, 2, 1, 9, 15, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ApplyWithPolicy(tt.t, tt.unit, tt.n, tt.sign, tt.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ApplyWithPolicy(%v, %q, %d, %d, %d) = %v, want %v", tt.t, tt.unit, tt.n, tt.sign, tt.policy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMonthsKeepsWallClockAcrossDST(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Jan 31 EST + 2 months lands in EDT with the same wall clock
+	got := AddMonths(time.Date(2024, 1, 31, 8, 0, 0, 0, loc), 2, Clamp)
+	if got.Month() != time.March || got.Day() != 31 || got.Hour() != 8 {
+		t.Errorf("AddMonths across DST = %v, want 2024-03-31 08:00 EDT", got)
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2024, time.April, 30},
+		{2024, time.December, 31},
+		{2024, 14, 28}, // normalized to February 2025
+	} {
+		if got := DaysIn(tt.year, tt.month); got != tt.want {
+			t.Errorf("DaysIn(%d, %d) = %d, want %d", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	t.Parallel()
+	weekdaysOnly := func(t time.Time) bool {
+		return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+	}
+	friday := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		n    int
+		sign int
+		want time.Time
+	}{
+		{"friday plus one is monday", friday, 1, +1, time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)},
+		{"friday plus five is friday", friday, 5, +1, time.Date(2026, 10, 23, 9, 30, 0, 0, time.UTC)},
+		{"monday minus one is friday", time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC), 1, -1, friday},
+		{"saturday plus one is monday", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), 1, +1, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"zero is identity on a weekend", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), 0, +1, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := AddBusinessDays(tt.t, tt.n, tt.sign, weekdaysOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: AddBusinessDays(%v, %d, %d) = %v, want %v", tt.name, tt.t, tt.n, tt.sign, got, tt.want)
+		}
+	}
+	never := func(time.Time) bool { return false }
+	if _, err := AddBusinessDays(friday, 1, +1, never); err == nil {
+		t.Error("expected an error when no business day exists, got nil")
+	}
+	// a huge count is rejected up front instead of stepping for minutes
+	if _, err := AddBusinessDays(friday, MaxBusinessDays+1, +1, weekdaysOnly); err == nil || !strings.Contains(err.Error(), "at most") {
+		t.Errorf("AddBusinessDays of %d: err = %v, want a limit error", MaxBusinessDays+1, err)
+	}
+}
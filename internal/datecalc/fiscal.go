@@ -0,0 +1,146 @@
+package datecalc
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalCalendar divides fiscal years into 4 quarters of 3 periods each.
+// With no Pattern, a fiscal year starts on the first day of StartMonth and
+// its periods are calendar months. With a retail Pattern, the weeks in each
+// quarter's three periods such as {4, 4, 5}, a fiscal year is 52 or 53 whole
+// weeks starting on the FirstDay nearest the first of StartMonth, and a 53rd
+// week joins the last period. Either way, week 1 starts on the year's first
+// day and later weeks start on FirstDay.
+//
+// Fiscal years here are identified by the calendar year in which they start.
+type FiscalCalendar struct {
+	StartMonth time.Month
+	Pattern    []int
+	FirstDay   time.Weekday
+}
+
+// fiscal units accepted by Bounds
+const (
+	FiscalYear    = "year"
+	FiscalQuarter = "quarter"
+	FiscalPeriod  = "period"
+	FiscalWeek    = "week"
+)
+
+// FiscalDate locates a date within a fiscal calendar
+type FiscalDate struct {
+	Year    int
+	Quarter int
+	Period  int
+	Week    int
+}
+
+// yearStart returns the civil date, at midnight UTC, on which the fiscal
+// year starting in the given calendar year begins
+func (c FiscalCalendar) yearStart(year int) time.Time {
+	first := time.Date(year, c.StartMonth, 1, 0, 0, 0, 0, time.UTC)
+	if len(c.Pattern) == 0 {
+		return first
+	}
+	ahead := (int(c.FirstDay) - int(first.Weekday()) + 7) % 7
+	if ahead > 3 {
+		ahead -= 7
+	}
+	return first.AddDate(0, 0, ahead)
+}
+
+// weekAnchor returns the civil date on which week 1 would start if it were
+// a whole week; later weeks start a multiple of 7 days after it
+func (c FiscalCalendar) weekAnchor(year int) time.Time {
+	start := c.yearStart(year)
+	back := (int(start.Weekday()) - int(c.FirstDay) + 7) % 7
+	return start.AddDate(0, 0, -back)
+}
+
+// WeeksIn returns the number of weeks, whole or partial, in the fiscal year
+func (c FiscalCalendar) WeeksIn(year int) int {
+	days := int(c.yearStart(year+1).Sub(c.weekAnchor(year)).Hours() / 24)
+	return (days + 6) / 7
+}
+
+// Locate returns the fiscal year, quarter, period, and week of t's
+// calendar date
+func (c FiscalCalendar) Locate(t time.Time) FiscalDate {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	year := d.Year()
+	if d.Before(c.yearStart(year)) {
+		year--
+	} else if !d.Before(c.yearStart(year + 1)) {
+		year++
+	}
+	fd := FiscalDate{Year: year}
+	fd.Week = int(d.Sub(c.weekAnchor(year)).Hours()/24)/7 + 1
+	if len(c.Pattern) == 0 {
+		months := (int(d.Month()) - int(c.StartMonth) + 12) % 12
+		fd.Quarter, fd.Period = months/3+1, months+1
+		return fd
+	}
+	// a 53rd week falls past the fourth quarter's 13 weeks and stays in it
+	weeks := fd.Week - 1
+	fd.Quarter = min(weeks/13, 3) + 1
+	weeks -= (fd.Quarter - 1) * 13
+	fd.Period = (fd.Quarter-1)*3 + 3
+	for i, cum := 0, 0; i < 2; i++ {
+		if cum += c.Pattern[i]; weeks < cum {
+			fd.Period = (fd.Quarter-1)*3 + i + 1
+			break
+		}
+	}
+	return fd
+}
+
+// Bounds returns the first and last days, at midnight in loc, of quarter
+// 1-4, period 1-12, or week 1-WeeksIn of the fiscal year, or of the year
+// itself, for which n is ignored
+func (c FiscalCalendar) Bounds(year int, unit string, n int, loc *time.Location) (start, end time.Time, err error) {
+	yearStart, next := c.yearStart(year), c.yearStart(year+1)
+	limit := map[string]int{FiscalYear: 1, FiscalQuarter: 4, FiscalPeriod: 12, FiscalWeek: c.WeeksIn(year)}[unit]
+	if limit == 0 {
+		return start, end, fmt.Errorf("unknown fiscal unit: %q", unit)
+	}
+	if unit == FiscalYear {
+		n = 1
+	}
+	if n < 1 || n > limit {
+		return start, end, fmt.Errorf("fiscal %s %d is out of range: the year has %ss 1 to %d", unit, n, unit, limit)
+	}
+	from, to := yearStart, next
+	switch {
+	case unit == FiscalWeek:
+		from = c.weekAnchor(year).AddDate(0, 0, (n-1)*7)
+		to = from.AddDate(0, 0, 7)
+	case unit == FiscalQuarter && len(c.Pattern) == 0:
+		from = yearStart.AddDate(0, (n-1)*3, 0)
+		to = from.AddDate(0, 3, 0)
+	case unit == FiscalPeriod && len(c.Pattern) == 0:
+		from = yearStart.AddDate(0, n-1, 0)
+		to = from.AddDate(0, 1, 0)
+	case unit == FiscalQuarter:
+		from = yearStart.AddDate(0, 0, (n-1)*13*7)
+		to = from.AddDate(0, 0, 13*7)
+	case unit == FiscalPeriod:
+		weeks := (n - 1) / 3 * 13
+		for i := 0; i < (n-1)%3; i++ {
+			weeks += c.Pattern[i]
+		}
+		from = yearStart.AddDate(0, 0, weeks*7)
+		to = from.AddDate(0, 0, c.Pattern[(n-1)%3]*7)
+	}
+	// partial first and last weeks are clipped to the year, and a retail
+	// year's 53rd week extends its last quarter and period
+	if from.Before(yearStart) {
+		from = yearStart
+	}
+	if to.After(next) || (len(c.Pattern) > 0 && unit != FiscalWeek && n == limit) {
+		to = next
+	}
+	last := to.AddDate(0, 0, -1)
+	return time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc),
+		time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, loc), nil
+}
@@ -0,0 +1,99 @@
+package datecalc
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	// federal fiscal years start on October 1
+	federal = FiscalCalendar{StartMonth: time.October, FirstDay: time.Monday}
+	// retail 4-5-4 years start on the Sunday nearest February 1
+	retail = FiscalCalendar{StartMonth: time.February, Pattern: []int{4, 5, 4}, FirstDay: time.Sunday}
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFiscalLocate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		date     time.Time
+		want     FiscalDate
+	}{
+		{"federal first day", federal, day(2026, 10, 1), FiscalDate{2026, 1, 1, 1}},
+		{"federal second month", federal, day(2026, 11, 3), FiscalDate{2026, 1, 2, 6}},
+		{"federal last day", federal, day(2027, 9, 30), FiscalDate{2026, 4, 12, 53}},
+		{"federal before the year starts", federal, day(2026, 9, 30), FiscalDate{2025, 4, 12, 53}},
+		{"retail first day", retail, day(2023, 1, 29), FiscalDate{2023, 1, 1, 1}},
+		{"retail five-week period", retail, day(2023, 3, 1), FiscalDate{2023, 1, 2, 5}},
+		{"retail 53rd week", retail, day(2024, 2, 3), FiscalDate{2023, 4, 12, 53}},
+		{"retail days before February", retail, day(2023, 1, 28), FiscalDate{2022, 4, 12, 52}},
+	}
+	for _, tt := range tests {
+		if got := tt.calendar.Locate(tt.date.Add(15 * time.Hour)); got != tt.want {
+			t.Errorf("%s: Locate(%s) = %+v, want %+v", tt.name, tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestFiscalBounds(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		year     int
+		unit     string
+		n        int
+		start    string
+		end      string
+	}{
+		{"federal year", federal, 2026, FiscalYear, 0, "2026-10-01", "2027-09-30"},
+		{"federal quarter", federal, 2026, FiscalQuarter, 2, "2027-01-01", "2027-03-31"},
+		{"federal period", federal, 2026, FiscalPeriod, 5, "2027-02-01", "2027-02-28"},
+		{"federal partial first week", federal, 2026, FiscalWeek, 1, "2026-10-01", "2026-10-04"},
+		{"federal whole week", federal, 2026, FiscalWeek, 6, "2026-11-02", "2026-11-08"},
+		{"federal partial last week", federal, 2026, FiscalWeek, 53, "2027-09-27", "2027-09-30"},
+		{"retail year", retail, 2023, FiscalYear, 1, "2023-01-29", "2024-02-03"},
+		{"retail five-week period", retail, 2023, FiscalPeriod, 2, "2023-02-26", "2023-04-01"},
+		{"retail last period holds the 53rd week", retail, 2023, FiscalPeriod, 12, "2023-12-31", "2024-02-03"},
+		{"retail last quarter holds the 53rd week", retail, 2023, FiscalQuarter, 4, "2023-10-29", "2024-02-03"},
+		{"retail 52-week year", retail, 2024, FiscalQuarter, 4, "2024-11-03", "2025-02-01"},
+	}
+	for _, tt := range tests {
+		start, end, err := tt.calendar.Bounds(tt.year, tt.unit, tt.n, time.UTC)
+		if err != nil {
+			t.Errorf("%s: Bounds error: %v", tt.name, err)
+			continue
+		}
+		if got, want := start.Format(time.DateOnly)+" "+end.Format(time.DateOnly), tt.start+" "+tt.end; got != want {
+			t.Errorf("%s: Bounds = %s, want %s", tt.name, got, want)
+		}
+	}
+}
+
+func TestFiscalBoundsInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		unit string
+		n    int
+		want string
+	}{
+		{FiscalQuarter, 5, "quarters 1 to 4"},
+		{FiscalPeriod, 0, "periods 1 to 12"},
+		{FiscalWeek, 54, "weeks 1 to 53"},
+		{"month", 1, "unknown fiscal unit"},
+	}
+	for _, tt := range tests {
+		if _, _, err := federal.Bounds(2026, tt.unit, tt.n, time.UTC); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Bounds(%s %d) error = %v, want it to mention %q", tt.unit, tt.n, err, tt.want)
+		}
+	}
+	if got := retail.WeeksIn(2024); got != 52 {
+		t.Errorf("retail WeeksIn(2024) = %d, want 52", got)
+	}
+}
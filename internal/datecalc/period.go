@@ -0,0 +1,49 @@
+package datecalc
+
+import (
+	"fmt"
+	"time"
+)
+
+// StartOf returns the first instant of the minute, hour, day, week, month,
+// quarter, or year holding t, in t's location; weeks start on Monday, as in
+// ISO 8601. Days and longer snap to local midnight, so a DST transition day
+// still starts at 00:00, while minutes and hours subtract elapsed time, so
+// an hour repeated by a DST change snaps to its own start.
+func StartOf(t time.Time, unit string) (time.Time, error) {
+	switch unit {
+	case "minute":
+		return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond())), nil
+	case "hour":
+		return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond())), nil
+	}
+	year, month, day := t.Date()
+	switch unit {
+	case "day":
+	case "week":
+		day -= (int(t.Weekday()) + 6) % 7
+	case "month":
+		day = 1
+	case "quarter":
+		month, day = (month-1)/3*3+1, 1
+	case "year":
+		month, day = time.January, 1
+	default:
+		return t, fmt.Errorf("unknown unit: %q", unit)
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location()), nil
+}
+
+// EndOf returns the last instant, one nanosecond before the next one
+// starts, of the minute, hour, day, week, month, quarter, or year holding t
+func EndOf(t time.Time, unit string) (time.Time, error) {
+	start, err := StartOf(t, unit)
+	if err != nil {
+		return t, err
+	}
+	next, err := Apply(start, unit, 1, 1)
+	if err != nil {
+		return t, err
+	}
+	return next.Add(-time.Nanosecond), nil
+}
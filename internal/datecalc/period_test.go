@@ -0,0 +1,48 @@
+package datecalc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartOfAndEndOf(t *testing.T) {
+	t.Parallel()
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sunday, March 8, 2026 is the spring-forward day: 02:00 EST jumps to 03:00 EDT
+	spring := time.Date(2026, 3, 8, 15, 45, 30, 5, ny)
+	// the first 01:30 on Sunday, November 1, 2026, before the clocks fall back
+	repeated := time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC).In(ny)
+	tests := []struct {
+		t     time.Time
+		unit  string
+		start string
+		end   string
+	}{
+		{spring, "minute", "2026-03-08 15:45:00 EDT", "2026-03-08 15:45:59.999999999 EDT"},
+		{spring, "hour", "2026-03-08 15:00:00 EDT", "2026-03-08 15:59:59.999999999 EDT"},
+		{spring, "day", "2026-03-08 00:00:00 EST", "2026-03-08 23:59:59.999999999 EDT"},
+		{spring, "week", "2026-03-02 00:00:00 EST", "2026-03-08 23:59:59.999999999 EDT"},
+		{spring, "month", "2026-03-01 00:00:00 EST", "2026-03-31 23:59:59.999999999 EDT"},
+		{spring, "quarter", "2026-01-01 00:00:00 EST", "2026-03-31 23:59:59.999999999 EDT"},
+		{spring, "year", "2026-01-01 00:00:00 EST", "2026-12-31 23:59:59.999999999 EST"},
+		{repeated, "hour", "2026-11-01 01:00:00 EDT", "2026-11-01 01:59:59.999999999 EDT"},
+		{repeated.Add(time.Hour), "hour", "2026-11-01 01:00:00 EST", "2026-11-01 01:59:59.999999999 EST"},
+	}
+	const layout = "2006-01-02 15:04:05.999999999 MST"
+	for _, tt := range tests {
+		start, err := StartOf(tt.t, tt.unit)
+		if err != nil || start.Format(layout) != tt.start {
+			t.Errorf("StartOf(%s, %s) = %s, %v; want %s", tt.t.Format(layout), tt.unit, start.Format(layout), err, tt.start)
+		}
+		end, err := EndOf(tt.t, tt.unit)
+		if err != nil || end.Format(layout) != tt.end {
+			t.Errorf("EndOf(%s, %s) = %s, %v; want %s", tt.t.Format(layout), tt.unit, end.Format(layout), err, tt.end)
+		}
+	}
+	if _, err := StartOf(spring, "fortnight"); err == nil {
+		t.Error("StartOf(fortnight) succeeded, want an error")
+	}
+}
@@ -0,0 +1,57 @@
+package datecalc
+
+import "time"
+
+// WeekRule numbers the weeks of a year: every week starts on FirstDay, and
+// week 1 is the first week holding at least MinDays (1-7) days of the new
+// year. ISO 8601 weeks start on Monday with a MinDays of 4, so week 1 holds
+// the year's first Thursday; US weeks start on Sunday with a MinDays of 1,
+// so week 1 holds January 1. Days before a year's week 1 belong to the last
+// week of the previous week-year.
+type WeekRule struct {
+	FirstDay time.Weekday
+	MinDays  int
+}
+
+// startOfWeek returns the civil date, at midnight UTC, of the first day of
+// the rule's week containing the given date
+func (r WeekRule) startOfWeek(year int, month time.Month, day int) time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	back := (int(d.Weekday()) - int(r.FirstDay) + 7) % 7
+	return d.AddDate(0, 0, -back)
+}
+
+// firstWeek returns the civil date, at midnight UTC, on which week 1 of the
+// week-year starts
+func (r WeekRule) firstWeek(year int) time.Time {
+	start := r.startOfWeek(year, time.January, 1)
+	// the days of this week before January 1 belong to the previous year
+	before := int(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Sub(start).Hours() / 24)
+	if 7-before < r.MinDays {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// Week returns the week-year and week number of t's calendar date; the
+// week-year differs from t's year for days at either end of the year
+func (r WeekRule) Week(t time.Time) (year, week int) {
+	start := r.startOfWeek(t.Year(), t.Month(), t.Day())
+	// the week belongs to the year holding its MinDays-th-from-last day
+	year = start.AddDate(0, 0, 7-r.MinDays).Year()
+	week = int(start.Sub(r.firstWeek(year)).Hours()/24)/7 + 1
+	return year, week
+}
+
+// WeeksIn returns the number of weeks in the week-year, 52 or 53
+func (r WeekRule) WeeksIn(year int) int {
+	return int(r.firstWeek(year+1).Sub(r.firstWeek(year)).Hours()/24) / 7
+}
+
+// WeekStart returns the first day of the given week, at midnight in loc;
+// week numbers outside 1 to WeeksIn(year) are normalized into the adjacent
+// week-years
+func (r WeekRule) WeekStart(year, week int, loc *time.Location) time.Time {
+	d := r.firstWeek(year).AddDate(0, 0, (week-1)*7)
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,59 @@
+package datecalc
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	iso = WeekRule{FirstDay: time.Monday, MinDays: 4}
+	us  = WeekRule{FirstDay: time.Sunday, MinDays: 1}
+)
+
+func TestWeekMatchesISOWeek(t *testing.T) {
+	t.Parallel()
+	for d := time.Date(1999, 12, 1, 0, 0, 0, 0, time.UTC); d.Year() < 2031; d = d.AddDate(0, 0, 1) {
+		wantYear, wantWeek := d.ISOWeek()
+		if year, week := iso.Week(d); year != wantYear || week != wantWeek {
+			t.Fatalf("Week(%s) = %d-W%02d, want %d-W%02d", d.Format(time.DateOnly), year, week, wantYear, wantWeek)
+		}
+		if start := iso.WeekStart(wantYear, wantWeek, time.UTC); start.Weekday() != time.Monday || d.Sub(start) < 0 || d.Sub(start) >= 7*24*time.Hour {
+			t.Fatalf("WeekStart(%d, %d) = %s, which does not hold %s", wantYear, wantWeek, start.Format(time.DateOnly), d.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestWeek(t *testing.T) {
+	t.Parallel()
+	saturday := WeekRule{FirstDay: time.Saturday, MinDays: 1}
+	tests := []struct {
+		name     string
+		rule     WeekRule
+		date     time.Time
+		year     int
+		week     int
+		weeksIn  int
+		starting string
+	}{
+		{"us January 1", us, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 2026, 1, 52, "2025-12-28"},
+		{"us mid year", us, time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC), 2026, 7, 52, "2026-02-08"},
+		{"us late December is next year's week 1", us, time.Date(2026, 12, 28, 0, 0, 0, 0, time.UTC), 2027, 1, 52, "2026-12-27"},
+		{"iso late December", iso, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), 2026, 53, 53, "2026-12-28"},
+		{"iso early January is last year's week", iso, time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC), 2026, 53, 53, "2026-12-28"},
+		{"saturday start", saturday, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), 2026, 1, 52, "2025-12-27"},
+		{"saturday start second week", saturday, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), 2026, 2, 52, "2026-01-03"},
+	}
+	for _, tt := range tests {
+		year, week := tt.rule.Week(tt.date)
+		if year != tt.year || week != tt.week {
+			t.Errorf("%s: Week = %d-W%02d, want %d-W%02d", tt.name, year, week, tt.year, tt.week)
+			continue
+		}
+		if got := tt.rule.WeeksIn(year); got != tt.weeksIn {
+			t.Errorf("%s: WeeksIn(%d) = %d, want %d", tt.name, year, got, tt.weeksIn)
+		}
+		if got := tt.rule.WeekStart(year, week, time.UTC).Format(time.DateOnly); got != tt.starting {
+			t.Errorf("%s: WeekStart = %s, want %s", tt.name, got, tt.starting)
+		}
+	}
+}
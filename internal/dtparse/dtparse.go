@@ -161,6 +161,14 @@ func outOfRange(layout string, err error) bool {
 // zone-less layouts (the root package passes time.Local); KindZoned results
 // are parsed with time.Parse and the caller must validate the zone.
 func Parse(source string, loc *time.Location) (time.Time, Kind, error) {
+	t, kind, _, err := ParseLayout(source, loc, time.Now())
+	return t, kind, err
+}
+
+// ParseLayout is Parse that stamps a bare time of day with the date of now
+// in loc, rather than of the current time, and also returns the matched
+// layout, for reporting how an input was read
+func ParseLayout(source string, loc *time.Location, now time.Time) (time.Time, Kind, string, error) {
 	for _, entry := range layouts {
 		var t time.Time
 		var err error
@@ -171,14 +179,14 @@ func Parse(source string, loc *time.Location) (time.Time, Kind, error) {
 		}
 		if err == nil {
 			if entry.kind == KindTimeOnly {
-				now := time.Now().In(loc)
+				now := now.In(loc)
 				t = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
 			}
-			return t, entry.kind, nil
+			return t, entry.kind, entry.layout, nil
 		}
 		if outOfRange(entry.layout, err) {
-			return time.Time{}, entry.kind, fmt.Errorf("invalid date/time %q: %v", source, err)
+			return time.Time{}, entry.kind, entry.layout, fmt.Errorf("invalid date/time %q: %v", source, err)
 		}
 	}
-	return time.Time{}, KindWallClock, fmt.Errorf("unable to parse date/time: %q", source)
+	return time.Time{}, KindWallClock, "", fmt.Errorf("unable to parse date/time: %q", source)
 }
@@ -138,6 +138,26 @@ func TestParseTimeOnlyStamping(t *testing.T) {
 	}
 }
 
+func TestParseLayoutStampsReferenceDate(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 20:00 UTC on March 2 is already March 3 in Tokyo
+	now := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+	parsed, kind, layout, err := ParseLayout("08:30", tokyo, now)
+	if err != nil {
+		t.Fatalf("ParseLayout: %v", err)
+	}
+	if kind != KindTimeOnly || layout != "15:4" {
+		t.Errorf("ParseLayout kind, layout = %v, %q", kind, layout)
+	}
+	if got := parsed.Format("2006-01-02 15:04 MST"); got != "2026-03-03 08:30 JST" {
+		t.Errorf("ParseLayout = %q, want 2026-03-03 08:30 JST", got)
+	}
+}
+
 func TestParseZonedKinds(t *testing.T) {
 	t.Parallel()
 	// each zoned layout must classify as KindZoned so the caller runs zone
@@ -0,0 +1,185 @@
+package dtparse
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/locale"
+)
+
+// dayRegexp matches a day of the month as written before a localized month
+// name: "3", "03", the German "3.", or the French "1er"
+var dayRegexp = regexp.MustCompile(`^(\d{1,2})(?:\.|er)?$`)
+
+// yearRegexp matches the 4-digit year that ends a localized date
+var yearRegexp = regexp.MustCompile(`^\d{4}$`)
+
+// Localize rewrites source, written with the month and weekday names or the
+// relative words of one of locales, into the English the rest of the
+// parsers read, so the layout table needs no shape per language: day-first
+// dates such as "mardi 3 mars 2026 à 14:30", "Dienstag, den 3. März 2026",
+// or "3 de marzo de 2026" become "Tue, Mar 3, 2026 14:30", "Tue, Mar 3,
+// 2026", and "Mar 3, 2026", a leading relative word becomes its English
+// equivalent, so "mañana" is "tomorrow" and "demain à 9:00" is "tomorrow
+// 9:00", and a full weekday name alone, such as "Montag" or "lunes à 9:00",
+// becomes "Monday" or "Monday 9:00". Locales are tried in order and the
+// first whose words read source wins; since languages share month names,
+// such as the Spanish and Italian "marzo", the connectors of every locale
+// are accepted before the time. ok is false, and source is returned
+// unchanged, when no locale reads source; err is set when a locale reads a
+// date whose weekday contradicts it, such as "mer 3 mars 2026" for a
+// Tuesday. English dates are never reshaped, since the layout table reads
+// their names as written: "Tue, 03 Mar 2026 14:05:00 +0000" is RFC 1123,
+// not a day-first date to rewrite into "Tue, Mar 3, 2026 14:05:00 +0000".
+func Localize(source string, locales []*locale.Locale) (string, bool, error) {
+	fields := strings.Fields(strings.ReplaceAll(source, "’", "'"))
+	var connectors []string
+	for _, l := range locales {
+		connectors = append(connectors, l.Connectors...)
+	}
+	for _, l := range locales {
+		if english, ok := localizeRelative(fields, l, connectors); ok {
+			return english, true, nil
+		}
+		if l.Name == "en" {
+			continue
+		}
+		if english, ok, err := localizeDate(fields, l, connectors); ok {
+			return english, true, err
+		}
+		if english, ok := localizeWeekday(fields, l, connectors); ok {
+			return english, true, nil
+		}
+	}
+	return source, false, nil
+}
+
+// localizeRelative translates a relative word that is the whole of source
+// or is followed by a time of day, which may be introduced by one of
+// connectors
+func localizeRelative(fields []string, l *locale.Locale, connectors []string) (string, bool) {
+	if len(fields) == 0 {
+		return "", false
+	}
+	english, ok := l.Relative[strings.ToLower(fields[0])]
+	if !ok {
+		return "", false
+	}
+	if rest := trimConnector(fields[1:], connectors); len(rest) > 0 {
+		english += " " + strings.Join(rest, " ")
+	}
+	return english, true
+}
+
+// localizeWeekday translates a full weekday name that is the whole of
+// source or is followed by a time of day, which may be introduced by one of
+// connectors and must not be a bare day of the month such as the "3" of
+// "lundi 3"; abbreviations such as the German "Mo" are too short to stand
+// alone
+func localizeWeekday(fields []string, l *locale.Locale, connectors []string) (string, bool) {
+	if len(fields) == 0 {
+		return "", false
+	}
+	field := strings.ToLower(strings.TrimSuffix(fields[0], ","))
+	for w := range l.Weekdays {
+		if field != strings.ToLower(l.Weekdays[w]) {
+			continue
+		}
+		rest := trimConnector(fields[1:], connectors)
+		if len(rest) > 0 && (rest[0][0] < '0' || rest[0][0] > '9' || dayRegexp.MatchString(rest[0])) {
+			return "", false
+		}
+		english := time.Weekday(w).String()
+		if len(rest) > 0 {
+			english += " " + strings.Join(rest, " ")
+		}
+		return english, true
+	}
+	return "", false
+}
+
+// localizeDate translates "[weekday] day month year [time]", in which any
+// part may be separated by one of l's fillers and the time may be
+// introduced by one of connectors, into the "Mon, Jan 2, 2006" shape of
+// dateBases; err is set when the weekday is not that of the date
+func localizeDate(fields []string, l *locale.Locale, connectors []string) (string, bool, error) {
+	next := func() string {
+		for len(fields) > 0 && slices.Contains(l.Fillers, strings.ToLower(fields[0])) {
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			return ""
+		}
+		field := strings.ToLower(strings.TrimSuffix(fields[0], ","))
+		fields = fields[1:]
+		return field
+	}
+
+	field := next()
+	weekday := -1
+	for w := range l.Weekdays {
+		if matchesName(field, l.Weekdays[w]) || matchesName(field, l.ShortWeekdays[w]) {
+			weekday = w
+			field = next()
+			break
+		}
+	}
+	m := dayRegexp.FindStringSubmatch(field)
+	if m == nil {
+		return "", false, nil
+	}
+	day, _ := strconv.Atoi(m[1])
+	field = next()
+	month := -1
+	for i := range l.Months {
+		if matchesName(field, l.Months[i]) || matchesName(field, l.ShortMonths[i]) {
+			month = i
+			break
+		}
+	}
+	if month < 0 {
+		return "", false, nil
+	}
+	year := next()
+	if !yearRegexp.MatchString(year) {
+		return "", false, nil
+	}
+
+	english := fmt.Sprintf("%s %d, %s", time.Month(month + 1).String()[:3], day, year)
+	if n, _ := strconv.Atoi(year); weekday >= 0 && day <= 31 {
+		date := time.Date(n, time.Month(month+1), day, 0, 0, 0, 0, time.UTC)
+		if date.Day() == day && date.Weekday() != time.Weekday(weekday) {
+			return "", true, fmt.Errorf("%s is a %s, not a %s", english, date.Weekday(), time.Weekday(weekday))
+		}
+	}
+	if weekday >= 0 {
+		english = time.Weekday(weekday).String()[:3] + ", " + english
+	}
+	if rest := trimConnector(fields, connectors); len(rest) > 0 {
+		english += " " + strings.Join(rest, " ")
+	}
+	return english, true, nil
+}
+
+// matchesName reports whether a lowercase field is name, ignoring a
+// trailing abbreviation period on either
+func matchesName(field, name string) bool {
+	return field != "" && strings.TrimSuffix(field, ".") == strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// trimConnector removes a leading connector, such as the "à" in "à 9:00"
+// or the "a las" in "a las 9:00", from the fields of the time of day that
+// follows a date or relative word
+func trimConnector(rest []string, connectors []string) []string {
+	for _, connector := range connectors {
+		words := strings.Fields(connector)
+		if len(rest) > len(words) && strings.EqualFold(strings.Join(rest[:len(words)], " "), connector) {
+			return rest[len(words):]
+		}
+	}
+	return rest
+}
@@ -0,0 +1,100 @@
+package dtparse
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/locale"
+)
+
+func testLocales(t *testing.T, names ...string) []*locale.Locale {
+	t.Helper()
+	var locales []*locale.Locale
+	for _, name := range names {
+		l, err := locale.Lookup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locales = append(locales, l)
+	}
+	return locales
+}
+
+func TestLocalize(t *testing.T) {
+	t.Parallel()
+	locales := testLocales(t, "fr", "de", "es", "it", "pt", "nl")
+	tests := map[string]string{
+		"3 mars 2026":                        "Mar 3, 2026",
+		"Mardi 3 MARS 2026 à 14:30":          "Tue, Mar 3, 2026 14:30",
+		"le 1er janvier 2027":                "Jan 1, 2027",
+		"jeu. 5 févr. 2026":                  "Thu, Feb 5, 2026",
+		"Dienstag, 3. März 2026":             "Tue, Mar 3, 2026",
+		"Dienstag, den 3. März 2026 um 9:00": "Tue, Mar 3, 2026 9:00",
+		"3 de marzo de 2026 a las 9:15 PM":   "Mar 3, 2026 9:15 PM",
+		"miércoles, 4 de marzo de 2026":      "Wed, Mar 4, 2026",
+		"3 marzo 2026 alle 18:00":            "Mar 3, 2026 18:00",
+		"terça-feira, 3 de março de 2026":    "Tue, Mar 3, 2026",
+		"3 maart 2026 om 8:15":               "Mar 3, 2026 8:15",
+		"mañana":                             "tomorrow",
+		"Demain à 9:00":                      "tomorrow 9:00",
+		"aujourd’hui":                        "today",
+		"gestern":                            "yesterday",
+		"ahora":                              "now",
+		"amanhã às 10:00":                    "tomorrow 10:00",
+		"vandaag 12:00":                      "today 12:00",
+		"Montag":                             "Monday",
+		"lunes":                              "Monday",
+		"lundi à 9:00":                       "Monday 9:00",
+	}
+	for source, want := range tests {
+		got, ok, err := Localize(source, locales)
+		if !ok || err != nil || got != want {
+			t.Errorf("Localize(%q) = %q, %v, %v; want %q", source, got, ok, err, want)
+		}
+		// a translated date must be a shape the layout table reads
+		if !strings.Contains(got, ",") {
+			continue
+		}
+		if _, _, err := Parse(got, time.UTC); err != nil {
+			t.Errorf("Parse(Localize(%q)): %v", source, err)
+		}
+	}
+}
+
+func TestLocalizeLeavesOtherInputUnchanged(t *testing.T) {
+	t.Parallel()
+	locales := testLocales(t, "fr", "de", "es")
+	for _, source := range []string{
+		"Mar 3, 2026", "Tue, Mar 3, 2026 14:30", "2026-03-03", "tomorrow",
+		"3 mars", "mars 3, 2026", "3 mars 26", "32 mars 2026x", "demainx", "",
+		"Mo", "lun", "lundi 3", "lundi prochain",
+	} {
+		if got, ok, _ := Localize(source, locales); ok || got != source {
+			t.Errorf("Localize(%q) = %q, %v; want it unchanged", source, got, ok)
+		}
+	}
+	// English dates keep their own shape, which the layout table reads
+	english := testLocales(t, "en", "fr")
+	for _, source := range []string{"Tue, 03 Mar 2026 14:05:00 +0000", "3 March 2026", "03 Mar 26 14:05 UTC"} {
+		if got, ok, _ := Localize(source, english); ok || got != source {
+			t.Errorf("Localize(%q) with en = %q, %v; want it unchanged", source, got, ok)
+		}
+	}
+	// a locale that is not configured is not read
+	if got, ok, _ := Localize("3 maart 2026", locales); ok {
+		t.Errorf("Localize(%q) = %q without the nl locale", "3 maart 2026", got)
+	}
+}
+
+func TestLocalizeContradictingWeekday(t *testing.T) {
+	t.Parallel()
+	locales := testLocales(t, "fr", "de")
+	// March 3, 2026 is a Tuesday
+	for _, source := range []string{"mer 3 mars 2026", "mercredi 3 mars 2026 à 14:30", "Mittwoch, 3. März 2026"} {
+		_, ok, err := Localize(source, locales)
+		if !ok || err == nil || !strings.Contains(err.Error(), "is a Tuesday, not a Wednesday") {
+			t.Errorf("Localize(%q) = %v, %v; want a contradicting weekday error", source, ok, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// builtin.go defines the built-in holiday sets: national public holidays
+// for a few countries, keyed by ISO 3166 country code. Regional holidays
+// and one-off moves (such as a bank holiday shifted for a jubilee) are out
+// of scope; add them from a file with Load.
+
+package holiday
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// builtin maps an uppercase set name to its set
+var builtin = map[string]*Set{
+	// United States federal holidays (5 U.S.C. 6103)
+	"US": {Name: "US", Rules: []Rule{
+		{Name: "New Year's Day", Kind: Fixed, Month: time.January, Day: 1, Observe: ObserveNearest},
+		{Name: "Martin Luther King Jr. Day", Kind: NthWeekday, Month: time.January, Weekday: time.Monday, N: 3, FirstYear: 1986},
+		{Name: "Washington's Birthday", Kind: NthWeekday, Month: time.February, Weekday: time.Monday, N: 3},
+		{Name: "Memorial Day", Kind: NthWeekday, Month: time.May, Weekday: time.Monday, N: -1},
+		{Name: "Juneteenth", Kind: Fixed, Month: time.June, Day: 19, Observe: ObserveNearest, FirstYear: 2021},
+		{Name: "Independence Day", Kind: Fixed, Month: time.July, Day: 4, Observe: ObserveNearest},
+		{Name: "Labor Day", Kind: NthWeekday, Month: time.September, Weekday: time.Monday, N: 1},
+		{Name: "Columbus Day", Kind: NthWeekday, Month: time.October, Weekday: time.Monday, N: 2},
+		{Name: "Veterans Day", Kind: Fixed, Month: time.November, Day: 11, Observe: ObserveNearest},
+		{Name: "Thanksgiving Day", Kind: NthWeekday, Month: time.November, Weekday: time.Thursday, N: 4},
+		{Name: "Christmas Day", Kind: Fixed, Month: time.December, Day: 25, Observe: ObserveNearest},
+	}},
+	// United Kingdom bank holidays for England and Wales
+	"UK": {Name: "UK", Rules: []Rule{
+		{Name: "New Year's Day", Kind: Fixed, Month: time.January, Day: 1, Observe: ObserveNext},
+		{Name: "Good Friday", Kind: Easter, Offset: -2},
+		{Name: "Easter Monday", Kind: Easter, Offset: 1},
+		{Name: "Early May Bank Holiday", Kind: NthWeekday, Month: time.May, Weekday: time.Monday, N: 1},
+		{Name: "Spring Bank Holiday", Kind: NthWeekday, Month: time.May, Weekday: time.Monday, N: -1},
+		{Name: "Summer Bank Holiday", Kind: NthWeekday, Month: time.August, Weekday: time.Monday, N: -1},
+		{Name: "Christmas Day", Kind: Fixed, Month: time.December, Day: 25, Observe: ObserveNext},
+		{Name: "Boxing Day", Kind: Fixed, Month: time.December, Day: 26, Observe: ObserveNext},
+	}},
+	// Canada federal statutory holidays
+	"CA": {Name: "CA", Rules: []Rule{
+		{Name: "New Year's Day", Kind: Fixed, Month: time.January, Day: 1, Observe: ObserveNext},
+		{Name: "Good Friday", Kind: Easter, Offset: -2},
+		// the Monday preceding May 25
+		{Name: "Victoria Day", Kind: NthWeekday, Month: time.May, Day: 24, Weekday: time.Monday, N: -1},
+		{Name: "Canada Day", Kind: Fixed, Month: time.July, Day: 1, Observe: ObserveNext},
+		{Name: "Labour Day", Kind: NthWeekday, Month: time.September, Weekday: time.Monday, N: 1},
+		{Name: "National Day for Truth and Reconciliation", Kind: Fixed, Month: time.September, Day: 30, Observe: ObserveNext, FirstYear: 2021},
+		{Name: "Thanksgiving", Kind: NthWeekday, Month: time.October, Weekday: time.Monday, N: 2},
+		{Name: "Remembrance Day", Kind: Fixed, Month: time.November, Day: 11, Observe: ObserveNext},
+		{Name: "Christmas Day", Kind: Fixed, Month: time.December, Day: 25, Observe: ObserveNext},
+		{Name: "Boxing Day", Kind: Fixed, Month: time.December, Day: 26, Observe: ObserveNext},
+	}},
+	// Germany nationwide public holidays; holidays on a weekend are not moved
+	"DE": {Name: "DE", Rules: []Rule{
+		{Name: "New Year's Day", Kind: Fixed, Month: time.January, Day: 1},
+		{Name: "Good Friday", Kind: Easter, Offset: -2},
+		{Name: "Easter Monday", Kind: Easter, Offset: 1},
+		{Name: "Labour Day", Kind: Fixed, Month: time.May, Day: 1},
+		{Name: "Ascension Day", Kind: Easter, Offset: 39},
+		{Name: "Whit Monday", Kind: Easter, Offset: 50},
+		{Name: "German Unity Day", Kind: Fixed, Month: time.October, Day: 3, FirstYear: 1990},
+		{Name: "Christmas Day", Kind: Fixed, Month: time.December, Day: 25},
+		{Name: "Second Day of Christmas", Kind: Fixed, Month: time.December, Day: 26},
+	}},
+	// France public holidays in metropolitan France outside Alsace-Moselle;
+	// holidays on a weekend are not moved
+	"FR": {Name: "FR", Rules: []Rule{
+		{Name: "New Year's Day", Kind: Fixed, Month: time.January, Day: 1},
+		{Name: "Easter Monday", Kind: Easter, Offset: 1},
+		{Name: "Labour Day", Kind: Fixed, Month: time.May, Day: 1},
+		{Name: "Victory in Europe Day", Kind: Fixed, Month: time.May, Day: 8},
+		{Name: "Ascension Day", Kind: Easter, Offset: 39},
+		{Name: "Whit Monday", Kind: Easter, Offset: 50},
+		{Name: "Bastille Day", Kind: Fixed, Month: time.July, Day: 14},
+		{Name: "Assumption Day", Kind: Fixed, Month: time.August, Day: 15},
+		{Name: "All Saints' Day", Kind: Fixed, Month: time.November, Day: 1},
+		{Name: "Armistice Day", Kind: Fixed, Month: time.November, Day: 11},
+		{Name: "Christmas Day", Kind: Fixed, Month: time.December, Day: 25},
+	}},
+}
+
+// Builtin returns the built-in set with the given name, case-insensitively;
+// "GB" is accepted for "UK".
+func Builtin(name string) (*Set, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "GB" {
+		name = "UK"
+	}
+	set, ok := builtin[name]
+	return set, ok
+}
+
+// Names returns the names of the built-in sets, sorted.
+func Names() []string {
+	return slices.Sorted(maps.Keys(builtin))
+}
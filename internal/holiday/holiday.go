@@ -0,0 +1,286 @@
+// Package holiday generates public holidays from rules: fixed dates, the
+// nth weekday of a month, and offsets from Easter Sunday, each with an
+// optional observance that moves a holiday falling on a Saturday or Sunday
+// to a nearby weekday. A Set combines rules with explicit one-off dates;
+// the built-in sets cover a few countries, and Load reads user-supplied
+// lists from JSON, CSV, and ICS files. Dates are civil dates represented as
+// midnight UTC; callers compare them by year, month, and day only.
+package holiday
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kind selects how a Rule computes its date for a given year.
+type Kind int
+
+const (
+	// Fixed falls on the same month and day every year.
+	Fixed Kind = iota
+	// NthWeekday falls on the Nth Weekday counted from Day of Month: a
+	// positive N counts forward from Day (default 1), a negative N counts
+	// backward from Day (default the last day of the month), so N=-1 is the
+	// last such weekday.
+	NthWeekday
+	// Easter falls Offset days from Western (Gregorian) Easter Sunday.
+	Easter
+)
+
+// Observance selects where a holiday falling on a Saturday or Sunday is
+// observed.
+type Observance int
+
+const (
+	// ObserveNone keeps the holiday on its actual date.
+	ObserveNone Observance = iota
+	// ObserveNearest moves Saturday to the preceding Friday and Sunday to
+	// the following Monday, as United States federal holidays do.
+	ObserveNearest
+	// ObserveNext moves the holiday to the next weekday that is not already
+	// a holiday, as United Kingdom bank holidays do: when Christmas is on a
+	// Saturday and Boxing Day on a Sunday, they are observed Monday and
+	// Tuesday.
+	ObserveNext
+)
+
+// observanceNames maps the names accepted by ParseObservance
+var observanceNames = map[string]Observance{
+	"none":    ObserveNone,
+	"nearest": ObserveNearest,
+	"next":    ObserveNext,
+}
+
+// ParseObservance parses an observance name: none, nearest, or next; an
+// empty name is none.
+func ParseObservance(name string) (Observance, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return ObserveNone, nil
+	}
+	observe, ok := observanceNames[name]
+	if !ok {
+		return ObserveNone, fmt.Errorf("invalid observance %q: expected none, nearest, or next", name)
+	}
+	return observe, nil
+}
+
+// Rule generates one named holiday per year. FirstYear and LastYear, when
+// non-zero, bound the years in which the holiday exists.
+type Rule struct {
+	Name      string
+	Kind      Kind
+	Month     time.Month
+	Day       int
+	Weekday   time.Weekday
+	N         int
+	Offset    int
+	Observe   Observance
+	FirstYear int
+	LastYear  int
+}
+
+// Date returns the rule's actual date in the given year; ok is false when
+// the holiday does not exist that year.
+func (r Rule) Date(year int) (date time.Time, ok bool) {
+	if (r.FirstYear != 0 && year < r.FirstYear) || (r.LastYear != 0 && year > r.LastYear) {
+		return time.Time{}, false
+	}
+	switch r.Kind {
+	case Fixed:
+		return civil(year, r.Month, r.Day), true
+	case NthWeekday:
+		return nthWeekday(year, r.Month, r.Day, r.Weekday, r.N), true
+	case Easter:
+		return EasterSunday(year).AddDate(0, 0, r.Offset), true
+	}
+	return time.Time{}, false
+}
+
+// validate reports a rule that cannot produce a date
+func (r Rule) validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("holiday rule has no name")
+	}
+	switch r.Kind {
+	case Fixed:
+		if r.Month < time.January || r.Month > time.December || r.Day < 1 || r.Day > 31 {
+			return fmt.Errorf("holiday %q: month %d, day %d is not a valid date", r.Name, r.Month, r.Day)
+		}
+	case NthWeekday:
+		if r.Month < time.January || r.Month > time.December || r.Day < 0 || r.Day > 31 {
+			return fmt.Errorf("holiday %q: month %d, day %d is not a valid anchor", r.Name, r.Month, r.Day)
+		}
+		if r.N == 0 || r.N < -5 || r.N > 5 {
+			return fmt.Errorf("holiday %q: nth must be 1 to 5 or -1 to -5: %d", r.Name, r.N)
+		}
+	case Easter:
+	default:
+		return fmt.Errorf("holiday %q: unknown rule kind %d", r.Name, r.Kind)
+	}
+	return nil
+}
+
+// Holiday is one occurrence of a holiday: Date is the day it is observed
+// and Actual the day it falls on; they differ only for an observed holiday.
+type Holiday struct {
+	Name   string
+	Date   time.Time
+	Actual time.Time
+}
+
+// Observed reports whether the holiday was moved off its actual date.
+func (h Holiday) Observed() bool {
+	return !h.Date.Equal(h.Actual)
+}
+
+// Set is a named collection of holiday rules and explicit dates.
+type Set struct {
+	Name  string
+	Rules []Rule
+	Dates []Holiday
+}
+
+// Holidays returns the holidays observed in the given year, sorted by date
+// and then name. An observed date may cross a year boundary: New Year's
+// Day on a Saturday is observed on Friday, December 31 of the prior year
+// under ObserveNearest, so it is listed in that prior year.
+func (s *Set) Holidays(year int) []Holiday {
+	var all []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		all = append(all, s.observe(y)...)
+	}
+	var holidays []Holiday
+	for _, h := range all {
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+	for _, h := range s.Dates {
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return holidays
+}
+
+// observe computes the rules' holidays for one year and applies each
+// rule's observance; ObserveNext skips weekdays already taken by another
+// holiday's actual date or by an earlier moved holiday, so a holiday that
+// falls on its own date keeps it
+func (s *Set) observe(year int) []Holiday {
+	var holidays []Holiday
+	var observances []Observance
+	taken := make(map[time.Time]bool)
+	for _, rule := range s.Rules {
+		date, ok := rule.Date(year)
+		if !ok {
+			continue
+		}
+		holidays = append(holidays, Holiday{Name: rule.Name, Date: date, Actual: date})
+		observances = append(observances, rule.Observe)
+		if !isWeekend(date) {
+			taken[date] = true
+		}
+	}
+	// moves run in date order, so Christmas on a Saturday claims Monday
+	// before Boxing Day on the Sunday does
+	order := make([]int, len(holidays))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return holidays[a].Actual.Compare(holidays[b].Actual)
+	})
+	for _, i := range order {
+		h := &holidays[i]
+		if !isWeekend(h.Actual) {
+			continue
+		}
+		switch observances[i] {
+		case ObserveNearest:
+			if h.Actual.Weekday() == time.Saturday {
+				h.Date = h.Actual.AddDate(0, 0, -1)
+			} else {
+				h.Date = h.Actual.AddDate(0, 0, 1)
+			}
+		case ObserveNext:
+			date := h.Actual
+			for isWeekend(date) || taken[date] {
+				date = date.AddDate(0, 0, 1)
+			}
+			h.Date = date
+			taken[date] = true
+		}
+	}
+	return holidays
+}
+
+// Validate reports the first rule in the set that cannot produce a date.
+func (s *Set) Validate() error {
+	for _, rule := range s.Rules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isWeekend reports whether the date is a Saturday or Sunday; observance
+// rules are defined against this weekend regardless of the weekend used
+// for business-day arithmetic
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+// civil returns the civil date as midnight UTC; out-of-range days
+// normalize the way time.Date does
+func civil(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// nthWeekday returns the nth weekday counted from the anchor day of the
+// month, forward for positive n and backward for negative n; an anchor of
+// 0 means the first day (forward) or the last day (backward)
+func nthWeekday(year int, month time.Month, anchor int, weekday time.Weekday, n int) time.Time {
+	if n > 0 {
+		if anchor == 0 {
+			anchor = 1
+		}
+		start := civil(year, month, anchor)
+		shift := (int(weekday) - int(start.Weekday()) + 7) % 7
+		return start.AddDate(0, 0, shift+(n-1)*7)
+	}
+	start := civil(year, month+1, 0)
+	if anchor != 0 {
+		start = civil(year, month, anchor)
+	}
+	shift := (int(start.Weekday()) - int(weekday) + 7) % 7
+	return start.AddDate(0, 0, -shift+(n+1)*7)
+}
+
+// EasterSunday returns Western (Gregorian) Easter Sunday of the given year,
+// computed with the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func EasterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return civil(year, time.Month(month), day)
+}
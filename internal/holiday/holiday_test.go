@@ -0,0 +1,181 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestEasterSunday(t *testing.T) {
+	t.Parallel()
+	tests := map[int]string{
+		1961: "1961-04-02",
+		2000: "2000-04-23",
+		2008: "2008-03-23", // early
+		2011: "2011-04-24",
+		2024: "2024-03-31",
+		2026: "2026-04-05",
+		2038: "2038-04-25", // latest possible
+		2285: "2285-03-22", // earliest possible
+	}
+	for year, want := range tests {
+		if got := EasterSunday(year); !got.Equal(date(want)) {
+			t.Errorf("EasterSunday(%d) = %s, want %s", year, got.Format(time.DateOnly), want)
+		}
+	}
+}
+
+func TestRuleDate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		rule Rule
+		year int
+		want string
+	}{
+		{"fixed", Rule{Kind: Fixed, Month: time.July, Day: 4}, 2026, "2026-07-04"},
+		{"third monday", Rule{Kind: NthWeekday, Month: time.January, Weekday: time.Monday, N: 3}, 2026, "2026-01-19"},
+		{"fourth thursday", Rule{Kind: NthWeekday, Month: time.November, Weekday: time.Thursday, N: 4}, 2026, "2026-11-26"},
+		{"first monday on the 1st", Rule{Kind: NthWeekday, Month: time.June, Weekday: time.Monday, N: 1}, 2026, "2026-06-01"},
+		{"last monday", Rule{Kind: NthWeekday, Month: time.May, Weekday: time.Monday, N: -1}, 2026, "2026-05-25"},
+		{"last monday on the 31st", Rule{Kind: NthWeekday, Month: time.May, Weekday: time.Monday, N: -1}, 2021, "2021-05-31"},
+		{"monday before may 25", Rule{Kind: NthWeekday, Month: time.May, Day: 24, Weekday: time.Monday, N: -1}, 2026, "2026-05-18"},
+		{"monday before may 25 on the 24th", Rule{Kind: NthWeekday, Month: time.May, Day: 24, Weekday: time.Monday, N: -1}, 2027, "2027-05-24"},
+		{"good friday", Rule{Kind: Easter, Offset: -2}, 2026, "2026-04-03"},
+		{"whit monday", Rule{Kind: Easter, Offset: 50}, 2026, "2026-05-25"},
+	}
+	for _, tt := range tests {
+		got, ok := tt.rule.Date(tt.year)
+		if !ok || !got.Equal(date(tt.want)) {
+			t.Errorf("%s: Date(%d) = %s, %v, want %s", tt.name, tt.year, got.Format(time.DateOnly), ok, tt.want)
+		}
+	}
+	bounded := Rule{Kind: Fixed, Month: time.June, Day: 19, FirstYear: 2021, LastYear: 2030}
+	for year, want := range map[int]bool{2020: false, 2021: true, 2030: true, 2031: false} {
+		if _, ok := bounded.Date(year); ok != want {
+			t.Errorf("bounded rule in %d: ok = %v, want %v", year, ok, want)
+		}
+	}
+}
+
+// observed returns the year's holidays as "date name" strings
+func observed(set *Set, year int) []string {
+	var out []string
+	for _, h := range set.Holidays(year) {
+		out = append(out, h.Date.Format(time.DateOnly)+" "+h.Name)
+	}
+	return out
+}
+
+func TestBuiltinUS(t *testing.T) {
+	t.Parallel()
+	us, ok := Builtin("us")
+	if !ok {
+		t.Fatal("expected a built-in US set")
+	}
+	want := []string{
+		"2026-01-01 New Year's Day",
+		"2026-01-19 Martin Luther King Jr. Day",
+		"2026-02-16 Washington's Birthday",
+		"2026-05-25 Memorial Day",
+		"2026-06-19 Juneteenth",
+		"2026-07-03 Independence Day",
+		"2026-09-07 Labor Day",
+		"2026-10-12 Columbus Day",
+		"2026-11-11 Veterans Day",
+		"2026-11-26 Thanksgiving Day",
+		"2026-12-25 Christmas Day",
+	}
+	assertHolidays(t, observed(us, 2026), want)
+}
+
+func TestObserveNearestCrossesYear(t *testing.T) {
+	t.Parallel()
+	us, _ := Builtin("US")
+	// New Year's Day 2022 fell on a Saturday and was observed Friday,
+	// December 31, 2021
+	got2021 := observed(us, 2021)
+	if last := got2021[len(got2021)-1]; last != "2021-12-31 New Year's Day" {
+		t.Errorf("last 2021 holiday = %q, want the observed New Year's Day", last)
+	}
+	if first := observed(us, 2022)[0]; first != "2022-01-17 Martin Luther King Jr. Day" {
+		t.Errorf("first 2022 holiday = %q, want Martin Luther King Jr. Day", first)
+	}
+}
+
+func TestObserveNextAvoidsCollisions(t *testing.T) {
+	t.Parallel()
+	uk, ok := Builtin("GB")
+	if !ok {
+		t.Fatal("expected GB to name the UK set")
+	}
+	// 2021: Christmas on Saturday, Boxing Day on Sunday
+	got := observed(uk, 2021)
+	assertHolidays(t, got[len(got)-2:], []string{"2021-12-27 Christmas Day", "2021-12-28 Boxing Day"})
+	// 2022: Christmas on Sunday, Boxing Day on Monday keeps its own date
+	got = observed(uk, 2022)
+	assertHolidays(t, got[len(got)-2:], []string{"2022-12-26 Boxing Day", "2022-12-27 Christmas Day"})
+	for _, h := range uk.Holidays(2022) {
+		if h.Name == "Christmas Day" && (!h.Observed() || !h.Actual.Equal(date("2022-12-25"))) {
+			t.Errorf("Christmas Day 2022: actual %s, observed %v", h.Actual.Format(time.DateOnly), h.Observed())
+		}
+	}
+}
+
+func TestObserveNoneKeepsWeekend(t *testing.T) {
+	t.Parallel()
+	de, _ := Builtin("DE")
+	// Second Day of Christmas 2026 is a Saturday and is not moved
+	got := observed(de, 2026)
+	if last := got[len(got)-1]; last != "2026-12-26 Second Day of Christmas" {
+		t.Errorf("last 2026 holiday = %q", last)
+	}
+}
+
+func TestBuiltinSetsValidate(t *testing.T) {
+	t.Parallel()
+	for _, name := range Names() {
+		set, _ := Builtin(name)
+		if err := set.Validate(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if len(set.Holidays(2026)) == 0 {
+			t.Errorf("%s: no holidays in 2026", name)
+		}
+	}
+	if _, ok := Builtin("XX"); ok {
+		t.Error("expected no built-in set named XX")
+	}
+}
+
+func TestParseObservance(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]Observance{"": ObserveNone, "None": ObserveNone, "nearest": ObserveNearest, " NEXT ": ObserveNext} {
+		got, err := ParseObservance(name)
+		if err != nil || got != want {
+			t.Errorf("ParseObservance(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseObservance("monday"); err == nil {
+		t.Error("expected an error for an unknown observance, got nil")
+	}
+}
+
+func assertHolidays(t *testing.T, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d holidays, want %d:\n%v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("holiday %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
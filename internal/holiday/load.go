@@ -0,0 +1,340 @@
+// load.go reads user-supplied holiday sets from JSON, CSV, and ICS files.
+// JSON entries may be explicit dates or rules; CSV rows are explicit dates;
+// ICS events are explicit dates, or rules when they carry a yearly RRULE.
+
+package holiday
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdayNames maps the accepted weekday spellings to time.Weekday
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseWeekday parses a weekday name, full or abbreviated, case-insensitively.
+func ParseWeekday(name string) (time.Weekday, error) {
+	day, ok := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return 0, fmt.Errorf("invalid weekday %q: expected a name such as mon or monday", name)
+	}
+	return day, nil
+}
+
+// fileTypes are the holiday file extensions Load reads
+var fileTypes = []string{".json", ".csv", ".ics"}
+
+// IsFile reports whether path names a holiday file by its extension.
+func IsFile(path string) bool {
+	return slices.Contains(fileTypes, strings.ToLower(filepath.Ext(path)))
+}
+
+// Load reads a holiday set from a file, choosing the format by extension:
+// .json, .csv, or .ics. The set is named after the file.
+func Load(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(path)
+	var set *Set
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		set, err = ParseJSON(bytes.NewReader(data), name)
+	case ".csv":
+		set, err = ParseCSV(bytes.NewReader(data), name)
+	case ".ics":
+		set, err = ParseICS(bytes.NewReader(data), name)
+	default:
+		return nil, fmt.Errorf("%s: unsupported holiday file type; expected .json, .csv, or .ics", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return set, nil
+}
+
+// jsonEntry is one JSON holiday: "date" gives an explicit date, "easter" an
+// offset from Easter Sunday, "weekday" with "month" and "nth" (and an
+// optional anchor "day") the nth weekday of a month, and "month" with "day"
+// a fixed date
+type jsonEntry struct {
+	Name     string `json:"name"`
+	Date     string `json:"date"`
+	Month    int    `json:"month"`
+	Day      int    `json:"day"`
+	Weekday  string `json:"weekday"`
+	Nth      int    `json:"nth"`
+	Easter   *int   `json:"easter"`
+	Observed string `json:"observed"`
+	From     int    `json:"from"`
+	Until    int    `json:"until"`
+}
+
+// ParseJSON reads a holiday set from JSON: either an array of entries or an
+// object with "name" and "holidays" fields, for example
+//
+//	{"name": "ACME", "holidays": [
+//	  {"name": "Company Day", "date": "2026-08-14"},
+//	  {"name": "Founders Day", "month": 3, "day": 2, "observed": "nearest"},
+//	  {"name": "Harvest Day", "month": 10, "weekday": "fri", "nth": -1},
+//	  {"name": "Easter Monday", "easter": 1}]}
+func ParseJSON(r io.Reader, name string) (*Set, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var entries []jsonEntry
+	decode := func(v any) error {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		return dec.Decode(v)
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = decode(&entries)
+	} else {
+		var doc struct {
+			Name     string      `json:"name"`
+			Holidays []jsonEntry `json:"holidays"`
+		}
+		err = decode(&doc)
+		entries = doc.Holidays
+		if doc.Name != "" {
+			name = doc.Name
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	set := &Set{Name: name}
+	for i, entry := range entries {
+		if err := entry.addTo(set); err != nil {
+			return nil, fmt.Errorf("holiday %d: %w", i+1, err)
+		}
+	}
+	return set, set.Validate()
+}
+
+// addTo adds the entry to the set as an explicit date or a rule
+func (e jsonEntry) addTo(set *Set) error {
+	if e.Date != "" {
+		date, err := time.Parse(time.DateOnly, e.Date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: expected YYYY-MM-DD", e.Date)
+		}
+		set.Dates = append(set.Dates, Holiday{Name: e.Name, Date: date, Actual: date})
+		return nil
+	}
+	observe, err := ParseObservance(e.Observed)
+	if err != nil {
+		return err
+	}
+	rule := Rule{Name: e.Name, Month: time.Month(e.Month), Day: e.Day, N: e.Nth, Observe: observe, FirstYear: e.From, LastYear: e.Until}
+	switch {
+	case e.Easter != nil:
+		rule.Kind, rule.Offset = Easter, *e.Easter
+	case e.Weekday != "":
+		if rule.Weekday, err = ParseWeekday(e.Weekday); err != nil {
+			return err
+		}
+		rule.Kind = NthWeekday
+	default:
+		rule.Kind = Fixed
+	}
+	set.Rules = append(set.Rules, rule)
+	return nil
+}
+
+// ParseCSV reads explicit holiday dates from CSV rows of date (YYYY-MM-DD)
+// and an optional name; a first row whose first field is "date" is a header
+// and lines starting with # are comments.
+func ParseCSV(r io.Reader, name string) (*Set, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	set := &Set{Name: name}
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := strings.TrimSpace(record[0])
+		if row == 1 && strings.EqualFold(field, "date") {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, field)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid date %q: expected YYYY-MM-DD", row, field)
+		}
+		holiday := Holiday{Date: date, Actual: date}
+		if len(record) > 1 {
+			holiday.Name = strings.TrimSpace(record[1])
+		}
+		set.Dates = append(set.Dates, holiday)
+	}
+	return set, nil
+}
+
+// icsWeekdays maps iCalendar BYDAY codes to time.Weekday
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// ParseICS reads holidays from the VEVENTs of an iCalendar file: each
+// event's DTSTART date and SUMMARY name. An event with a yearly RRULE
+// becomes a rule: a fixed date, or with BYMONTH and BYDAY (such as
+// "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH") the nth weekday of that month;
+// UNTIL and COUNT bound its years. Other recurrences are rejected. Only
+// the start date of a multi-day event is used.
+func ParseICS(r io.Reader, name string) (*Set, error) {
+	lines, err := unfoldICS(r)
+	if err != nil {
+		return nil, err
+	}
+	set := &Set{Name: name}
+	var event map[string]string
+	for _, line := range lines {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		// drop parameters such as DTSTART;VALUE=DATE
+		prop, _, _ := strings.Cut(strings.ToUpper(key), ";")
+		switch {
+		case prop == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = make(map[string]string)
+		case prop == "END" && strings.EqualFold(value, "VEVENT") && event != nil:
+			if err := addICSEvent(set, event); err != nil {
+				return nil, err
+			}
+			event = nil
+		case event != nil:
+			event[prop] = value
+		}
+	}
+	return set, set.Validate()
+}
+
+// unfoldICS splits iCalendar content into logical lines, joining the
+// continuation lines that start with a space or tab
+func unfoldICS(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// icsText unescapes an iCalendar TEXT value
+var icsText = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`)
+
+// addICSEvent adds one VEVENT's properties to the set
+func addICSEvent(set *Set, event map[string]string) error {
+	start := event["DTSTART"]
+	if len(start) < 8 {
+		return fmt.Errorf("event %q: missing or invalid DTSTART %q", event["SUMMARY"], start)
+	}
+	date, err := time.Parse("20060102", start[:8])
+	if err != nil {
+		return fmt.Errorf("event %q: invalid DTSTART %q", event["SUMMARY"], start)
+	}
+	name := strings.TrimSpace(icsText.Replace(event["SUMMARY"]))
+	rrule, ok := event["RRULE"]
+	if !ok {
+		set.Dates = append(set.Dates, Holiday{Name: name, Date: date, Actual: date})
+		return nil
+	}
+	rule, err := yearlyRule(rrule, date)
+	if err != nil {
+		return fmt.Errorf("event %q: %w", name, err)
+	}
+	rule.Name = name
+	set.Rules = append(set.Rules, rule)
+	return nil
+}
+
+// yearlyRule converts a yearly RRULE starting on the given date into a Rule
+func yearlyRule(rrule string, start time.Time) (Rule, error) {
+	rule := Rule{Kind: Fixed, Month: start.Month(), Day: start.Day(), FirstYear: start.Year()}
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		parts[strings.ToUpper(key)] = strings.ToUpper(value)
+	}
+	if parts["FREQ"] != "YEARLY" || (parts["INTERVAL"] != "" && parts["INTERVAL"] != "1") {
+		return rule, fmt.Errorf("unsupported RRULE %q: only yearly recurrences are supported", rrule)
+	}
+	for key, value := range parts {
+		switch key {
+		case "FREQ", "INTERVAL":
+		case "BYMONTH":
+			month, err := strconv.Atoi(value)
+			if err != nil || month < 1 || month > 12 {
+				return rule, fmt.Errorf("invalid BYMONTH %q in RRULE %q", value, rrule)
+			}
+			rule.Month = time.Month(month)
+		case "BYMONTHDAY":
+			day, err := strconv.Atoi(value)
+			if err != nil || day < 1 || day > 31 {
+				return rule, fmt.Errorf("invalid BYMONTHDAY %q in RRULE %q", value, rrule)
+			}
+			rule.Day = day
+		case "BYDAY":
+			code := value[max(len(value)-2, 0):]
+			weekday, ok := icsWeekdays[code]
+			n, err := strconv.Atoi(strings.TrimPrefix(value[:len(value)-len(code)], "+"))
+			if !ok || err != nil {
+				return rule, fmt.Errorf("unsupported BYDAY %q in RRULE %q: expected a single day with a position, such as 4TH or -1MO", value, rrule)
+			}
+			rule.Kind, rule.Weekday, rule.N, rule.Day = NthWeekday, weekday, n, 0
+		case "UNTIL":
+			until, err := time.Parse("20060102", value[:min(len(value), 8)])
+			if err != nil {
+				return rule, fmt.Errorf("invalid UNTIL %q in RRULE %q", value, rrule)
+			}
+			rule.LastYear = until.Year()
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return rule, fmt.Errorf("invalid COUNT %q in RRULE %q", value, rrule)
+			}
+			rule.LastYear = start.Year() + count - 1
+		default:
+			return rule, fmt.Errorf("unsupported RRULE part %s in %q", key, rrule)
+		}
+	}
+	if rule.Kind == NthWeekday && parts["BYMONTHDAY"] != "" {
+		return rule, fmt.Errorf("unsupported RRULE %q: BYDAY and BYMONTHDAY cannot be combined", rrule)
+	}
+	return rule, nil
+}
@@ -0,0 +1,129 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+	const doc = `{"name": "ACME", "holidays": [
+		{"name": "Company Day", "date": "2026-08-14"},
+		{"name": "Founders Day", "month": 3, "day": 7, "observed": "nearest"},
+		{"name": "Harvest Day", "month": 10, "weekday": "fri", "nth": -1},
+		{"name": "Easter Monday", "easter": 1},
+		{"name": "Retired Day", "month": 2, "day": 2, "until": 2020}]}`
+	set, err := ParseJSON(strings.NewReader(doc), "acme.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Name != "ACME" {
+		t.Errorf("set name = %q, want the document's name", set.Name)
+	}
+	assertHolidays(t, observed(set, 2026), []string{
+		"2026-03-06 Founders Day",
+		"2026-04-06 Easter Monday",
+		"2026-08-14 Company Day",
+		"2026-10-30 Harvest Day",
+	})
+
+	array, err := ParseJSON(strings.NewReader(`[{"name": "Day Off", "date": "2026-01-02"}]`), "days.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertHolidays(t, observed(array, 2026), []string{"2026-01-02 Day Off"})
+}
+
+func TestParseJSONInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []string{
+		`{"holidays": [{"name": "Typo", "dat": "2026-01-02"}]}`,
+		`[{"name": "Bad Date", "date": "01/02/2026"}]`,
+		`[{"name": "Bad Weekday", "month": 5, "weekday": "someday", "nth": 1}]`,
+		`[{"name": "No Nth", "month": 5, "weekday": "mon"}]`,
+		`[{"name": "Bad Month", "month": 13, "day": 1}]`,
+		`[{"month": 1, "day": 1}]`,
+		`[{"name": "Bad Observance", "month": 1, "day": 1, "observed": "later"}]`,
+		`not json`,
+	}
+	for _, doc := range tests {
+		if _, err := ParseJSON(strings.NewReader(doc), "bad.json"); err == nil {
+			t.Errorf("ParseJSON(%s): expected an error, got nil", doc)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+	const doc = "date,name\n# company closures\n2026-12-24,Christmas Eve\n2026-12-31, New Year's Eve\n2027-01-04\n"
+	set, err := ParseCSV(strings.NewReader(doc), "closures.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertHolidays(t, observed(set, 2026), []string{"2026-12-24 Christmas Eve", "2026-12-31 New Year's Eve"})
+	assertHolidays(t, observed(set, 2027), []string{"2027-01-04 "})
+	if _, err := ParseCSV(strings.NewReader("2026-12-24\nDec 31\n"), "bad.csv"); err == nil || !strings.Contains(err.Error(), "row 2") {
+		t.Errorf("expected an error naming row 2, got: %v", err)
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	t.Parallel()
+	const doc = "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260814\r\nSUMMARY:Company Day\\, observed\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20200101\r\nRRULE:FREQ=YEARLY\r\nSUMMARY:New Year's Day\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20201126\r\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\r\n" +
+		"SUMMARY:Thanks\r\n giving\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART:20100301T000000Z\r\nRRULE:FREQ=YEARLY;COUNT=3\r\nSUMMARY:Expired\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	set, err := ParseICS(strings.NewReader(doc), "company.ics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertHolidays(t, observed(set, 2026), []string{
+		"2026-01-01 New Year's Day",
+		"2026-08-14 Company Day, observed",
+		"2026-11-26 Thanksgiving",
+	})
+	assertHolidays(t, observed(set, 2011), []string{"2011-03-01 Expired"})
+	if got := observed(set, 2019); len(got) != 0 {
+		t.Errorf("expected no holidays before the rules start, got %v", got)
+	}
+}
+
+func TestParseICSUnsupportedRecurrence(t *testing.T) {
+	t.Parallel()
+	for _, rrule := range []string{"FREQ=MONTHLY", "FREQ=YEARLY;INTERVAL=2", "FREQ=YEARLY;BYDAY=MO", "FREQ=YEARLY;BYWEEKNO=20"} {
+		doc := "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260105\nRRULE:" + rrule + "\nSUMMARY:Day\nEND:VEVENT\n"
+		if _, err := ParseICS(strings.NewReader(doc), "bad.ics"); err == nil {
+			t.Errorf("RRULE %s: expected an error, got nil", rrule)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "days.CSV")
+	if err := os.WriteFile(path, []byte("2026-08-14,Company Day\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !IsFile(path) {
+		t.Errorf("IsFile(%q) = false, want true", path)
+	}
+	set, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Name != "days.CSV" {
+		t.Errorf("set name = %q, want the file name", set.Name)
+	}
+	if IsFile("2026-08-14") || IsFile("US") {
+		t.Error("expected dates and set names not to be holiday files")
+	}
+	if _, err := Load(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected an error for a missing file, got nil")
+	}
+}
@@ -0,0 +1,182 @@
+// Package locale holds month and weekday names, meridiem markers, and date
+// and time representations for a few languages. The tables are compiled in,
+// so formatting never depends on the operating system's locale data and
+// gives the same output on every machine. Names and representations follow
+// the glibc locales of each language's main country.
+package locale
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Locale is one language's names and representations. DateTime, Date, and
+// Time are the strftime patterns behind %c, %x, and %X. An empty AM or PM
+// keeps the English marker, for languages that use the 24-hour clock.
+//
+// For parsing, Relative maps lowercase words such as "demain" to the
+// English relative dates they mean, Connectors are the words that may
+// introduce a time of day, as "à" does in "demain à 9:00", and Fillers are
+// the words a written date may hold between its parts, as "de" does in
+// "3 de marzo de 2026".
+type Locale struct {
+	Name          string
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+	AM            string
+	PM            string
+	DateTime      string
+	Date          string
+	Time          string
+	Relative      map[string]string
+	Connectors    []string
+	Fillers       []string
+}
+
+// Month returns the full name of m
+func (l *Locale) Month(m time.Month) string { return l.Months[m-1] }
+
+// ShortMonth returns the abbreviated name of m
+func (l *Locale) ShortMonth(m time.Month) string { return l.ShortMonths[m-1] }
+
+// Weekday returns the full name of w
+func (l *Locale) Weekday(w time.Weekday) string { return l.Weekdays[w] }
+
+// ShortWeekday returns the abbreviated name of w
+func (l *Locale) ShortWeekday(w time.Weekday) string { return l.ShortWeekdays[w] }
+
+// Meridiem returns the AM or PM marker for an hour from 0 to 23
+func (l *Locale) Meridiem(hour int) string {
+	am, pm := l.AM, l.PM
+	if am == "" {
+		am = "AM"
+	}
+	if pm == "" {
+		pm = "PM"
+	}
+	if hour < 12 {
+		return am
+	}
+	return pm
+}
+
+// builtin maps a lowercase language code to its locale
+var builtin = map[string]*Locale{
+	"en": {
+		Name:          "en",
+		Months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		ShortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		DateTime:      "%a %b %e %H:%M:%S %Y",
+		Date:          "%m/%d/%y",
+		Time:          "%H:%M:%S",
+		Connectors:    []string{"at"},
+	},
+	"fr": {
+		Name:          "fr",
+		Months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortMonths:   [12]string{"janv.", "févr.", "mars", "avril", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d/%m/%Y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"hier": "yesterday", "aujourd'hui": "today", "demain": "tomorrow", "maintenant": "now"},
+		Connectors:    []string{"à"},
+		Fillers:       []string{"le"},
+	},
+	"de": {
+		Name:          "de",
+		Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		ShortMonths:   [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		ShortWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d.%m.%Y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"gestern": "yesterday", "heute": "today", "morgen": "tomorrow", "jetzt": "now"},
+		Connectors:    []string{"um"},
+		Fillers:       []string{"den"},
+	},
+	"es": {
+		Name:          "es",
+		Months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		ShortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		Weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		ShortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		AM:            "a. m.",
+		PM:            "p. m.",
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d/%m/%y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"ayer": "yesterday", "hoy": "today", "mañana": "tomorrow", "ahora": "now"},
+		Connectors:    []string{"a las", "a la"},
+		Fillers:       []string{"de"},
+	},
+	"it": {
+		Name:          "it",
+		Months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		ShortMonths:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		Weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		ShortWeekdays: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d/%m/%Y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"ieri": "yesterday", "oggi": "today", "domani": "tomorrow", "adesso": "now"},
+		Connectors:    []string{"alle"},
+	},
+	"pt": {
+		Name:          "pt",
+		Months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		ShortMonths:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		Weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		ShortWeekdays: [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d/%m/%Y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"ontem": "yesterday", "hoje": "today", "amanhã": "tomorrow", "agora": "now"},
+		Connectors:    []string{"às", "à"},
+		Fillers:       []string{"de"},
+	},
+	"nl": {
+		Name:          "nl",
+		Months:        [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		ShortMonths:   [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		Weekdays:      [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		ShortWeekdays: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		DateTime:      "%a %d %b %Y %H:%M:%S",
+		Date:          "%d-%m-%Y",
+		Time:          "%H:%M:%S",
+		Relative:      map[string]string{"gisteren": "yesterday", "vandaag": "today", "morgen": "tomorrow", "nu": "now"},
+		Connectors:    []string{"om"},
+	},
+}
+
+// Names returns the language codes of the built-in locales, sorted
+func Names() []string {
+	return slices.Sorted(maps.Keys(builtin))
+}
+
+// Lookup returns the locale for a language code such as "fr", or a POSIX
+// locale name such as "fr_FR.UTF-8" or "de_DE@euro", whose language alone
+// selects the locale; "C" and "POSIX" are English
+func Lookup(name string) (*Locale, error) {
+	lang := strings.TrimSpace(name)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[:i]
+	}
+	lang = strings.ToLower(lang)
+	if lang == "c" || lang == "posix" {
+		lang = "en"
+	}
+	if l, ok := builtin[lang]; ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("unknown locale %q: expected one of %s", name, strings.Join(Names(), ", "))
+}
@@ -0,0 +1,96 @@
+package locale
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"fr":          "fr",
+		"DE":          "de",
+		"de_DE.UTF-8": "de",
+		"pt-BR":       "pt",
+		"nl_NL@euro":  "nl",
+		"C":           "en",
+		"POSIX":       "en",
+		" es ":        "es",
+	}
+	for name, want := range tests {
+		l, err := Lookup(name)
+		if err != nil || l.Name != want {
+			t.Errorf("Lookup(%q) = %v, %v; want %s", name, l, err, want)
+		}
+	}
+	for _, name := range []string{"", "ja_JP.UTF-8", "french"} {
+		if _, err := Lookup(name); err == nil || !strings.Contains(err.Error(), "de, en, es, fr, it, nl, pt") {
+			t.Errorf("Lookup(%q) error = %v, want an unknown locale error", name, err)
+		}
+	}
+}
+
+func TestTablesComplete(t *testing.T) {
+	t.Parallel()
+	if got := Names(); !slices.Equal(got, []string{"de", "en", "es", "fr", "it", "nl", "pt"}) {
+		t.Errorf("Names() = %v", got)
+	}
+	for _, name := range Names() {
+		l, _ := Lookup(name)
+		for m := time.January; m <= time.December; m++ {
+			if l.Month(m) == "" || l.ShortMonth(m) == "" {
+				t.Errorf("%s: missing name for %v", name, m)
+			}
+		}
+		for w := time.Sunday; w <= time.Saturday; w++ {
+			if l.Weekday(w) == "" || l.ShortWeekday(w) == "" {
+				t.Errorf("%s: missing name for %v", name, w)
+			}
+		}
+		if l.DateTime == "" || l.Date == "" || l.Time == "" {
+			t.Errorf("%s: missing a date or time representation", name)
+		}
+	}
+}
+
+func TestMeridiem(t *testing.T) {
+	t.Parallel()
+	es, _ := Lookup("es")
+	de, _ := Lookup("de")
+	tests := []struct {
+		locale *Locale
+		hour   int
+		want   string
+	}{
+		{es, 0, "a. m."},
+		{es, 12, "p. m."},
+		{de, 11, "AM"},
+		{de, 23, "PM"},
+	}
+	for _, tt := range tests {
+		if got := tt.locale.Meridiem(tt.hour); got != tt.want {
+			t.Errorf("%s Meridiem(%d) = %q, want %q", tt.locale.Name, tt.hour, got, tt.want)
+		}
+	}
+}
+
+func TestRelativeWords(t *testing.T) {
+	t.Parallel()
+	english := []string{"now", "today", "tomorrow", "yesterday"}
+	for _, name := range Names() {
+		l, _ := Lookup(name)
+		if name != "en" && len(l.Relative) != len(english) {
+			t.Errorf("%s: has %d relative words, want %d", name, len(l.Relative), len(english))
+		}
+		for word, meaning := range l.Relative {
+			if word != strings.ToLower(word) || !slices.Contains(english, meaning) {
+				t.Errorf("%s: relative word %q means %q", name, word, meaning)
+			}
+		}
+		if len(l.Connectors) == 0 {
+			t.Errorf("%s: missing a connector before a time of day", name)
+		}
+	}
+}
@@ -0,0 +1,517 @@
+// Package reldate resolves natural-language relative dates, such as
+// "3 days ago", "in 2 weeks", "next friday", "last monday of march",
+// "tomorrow 9am", "noon", "end of month", or "beginning of next quarter",
+// against a reference time and in its location. A phrase is a sequence of
+// up to three components, each used at most once: an anchor day or
+// period, a shift ("3 days ago", "in 2 hours"), and a time of day. The
+// anchor is resolved first, then shifted, then given the time of day.
+package reldate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+// ordinals number the weekdays of a month; -1 is the last one
+var ordinals = map[string]int{
+	"first": 1, "1st": 1, "second": 2, "2nd": 2, "third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4, "fifth": 5, "5th": 5, "last": -1,
+}
+
+var ordinalNames = map[int]string{1: "first", 2: "second", 3: "third", 4: "fourth", 5: "fifth", -1: "last"}
+
+// unit is a shift unit: calendar units move the date with AddDate, keeping
+// the wall clock, while clock units add exact elapsed time
+type unit struct {
+	name                string
+	years, months, days int
+	elapsed             time.Duration
+}
+
+var units = map[string]unit{}
+
+func init() {
+	for _, u := range []struct {
+		names []string
+		unit  unit
+	}{
+		{[]string{"second", "seconds", "sec", "secs"}, unit{name: "second", elapsed: time.Second}},
+		{[]string{"minute", "minutes", "min", "mins"}, unit{name: "minute", elapsed: time.Minute}},
+		{[]string{"hour", "hours", "hr", "hrs"}, unit{name: "hour", elapsed: time.Hour}},
+		{[]string{"day", "days"}, unit{name: "day", days: 1}},
+		{[]string{"week", "weeks"}, unit{name: "week", days: 7}},
+		{[]string{"fortnight", "fortnights"}, unit{name: "fortnight", days: 14}},
+		{[]string{"month", "months"}, unit{name: "month", months: 1}},
+		{[]string{"quarter", "quarters"}, unit{name: "quarter", months: 3}},
+		{[]string{"year", "years"}, unit{name: "year", years: 1}},
+	} {
+		for _, name := range u.names {
+			units[name] = u.unit
+		}
+	}
+}
+
+// maxAmount bounds each shift amount, keeping the elapsed time of a clock
+// unit well inside time.Duration's range
+const maxAmount = 1_000_000
+
+// periods are the spans that "beginning of" and "end of" bound
+var periods = map[string]bool{"day": true, "week": true, "month": true, "quarter": true, "year": true}
+
+// relatives select the current, following, or preceding period or weekday
+var relatives = map[string]int{"this": 0, "next": 1, "last": -1, "previous": -1}
+
+// keywords start only relative phrases, so input beginning with one is
+// claimed even when the rest is malformed
+var keywords = map[string]bool{
+	"now": true, "today": true, "tomorrow": true, "yesterday": true, "noon": true, "midnight": true,
+	"next": true, "last": true, "previous": true, "this": true, "in": true, "at": true,
+	"beginning": true, "start": true, "end": true,
+}
+
+// clockRegexp matches a time of day: "9am", "9:30 pm", "14:30", or
+// "14:30:15.5"; without am/pm a colon is required, so a bare number is
+// never read as an hour
+var clockRegexp = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2})(?:\.(\d{1,9}))?)?(am|pm|a\.m\.|p\.m\.)?$`)
+
+// Parse resolves source against ref, in ref's location. claimed reports
+// whether source is a relative phrase at all: when false, the caller
+// should try its other parsers. phrase is the canonical form of what was
+// understood, with defaults filled in, such as "last monday of march 2026"
+// or "tomorrow at 09:00", so a misreading is visible. A time of day alone,
+// such as "9am" or "14:30", is today at that time.
+func Parse(source string, ref time.Time) (t time.Time, phrase string, claimed bool, err error) {
+	p := &parser{source: source, tokens: tokenize(source), ref: ref}
+	if len(p.tokens) == 0 {
+		return time.Time{}, "", false, nil
+	}
+	t, err = p.parse()
+	if err != nil {
+		first := p.tokens[0]
+		_, isUnit := units[second(p.tokens)]
+		_, isWeekday := weekdays[second(p.tokens)]
+		strong := keywords[first] || (isAmount(first) && isUnit) || (ordinals[first] != 0 && isWeekday)
+		return time.Time{}, "", strong, err
+	}
+	return t, strings.Join(p.phrases, " "), true, nil
+}
+
+// TimeOfDayOnly reports whether phrase, as returned by Parse, is a time of
+// day alone, such as "at 09:00" for "9am", with no day or shift
+func TimeOfDayOnly(phrase string) bool {
+	clock, ok := strings.CutPrefix(phrase, "at ")
+	return ok && !strings.Contains(clock, " ")
+}
+
+func tokenize(source string) []string {
+	source = strings.ToLower(strings.ReplaceAll(source, ",", " "))
+	return strings.Fields(source)
+}
+
+func second(tokens []string) string {
+	if len(tokens) < 2 {
+		return ""
+	}
+	return tokens[1]
+}
+
+func isAmount(token string) bool {
+	if token == "a" || token == "an" {
+		return true
+	}
+	_, err := strconv.Atoi(token)
+	return err == nil
+}
+
+type parser struct {
+	source string
+	tokens []string
+	pos    int
+	ref    time.Time
+
+	phrases []string
+
+	hasAnchor bool
+	anchor    time.Time
+
+	hasShift              bool
+	years, months, days   int
+	elapsed               time.Duration
+	hasClock              bool
+	hour, minute, sec, ns int
+}
+
+func (p *parser) peek(offset int) string {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+	return ""
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid relative date %q: %s", p.source, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) parse() (time.Time, error) {
+	for p.pos < len(p.tokens) {
+		start := p.pos
+		ok, err := p.parseClock()
+		if !ok && err == nil {
+			ok, err = p.parseShift()
+		}
+		if !ok && err == nil {
+			ok, err = p.parseAnchor()
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !ok {
+			if start == 0 {
+				return time.Time{}, p.errorf("%q is not understood", p.tokens[start])
+			}
+			return time.Time{}, p.errorf("understood %q but not %q", strings.Join(p.tokens[:start], " "), strings.Join(p.tokens[start:], " "))
+		}
+	}
+
+	t := p.ref
+	if p.hasAnchor {
+		t = p.anchor
+	}
+	if p.hasShift {
+		t = t.AddDate(p.years, p.months, p.days).Add(p.elapsed)
+	}
+	if p.hasClock {
+		t = time.Date(t.Year(), t.Month(), t.Day(), p.hour, p.minute, p.sec, p.ns, t.Location())
+	}
+	return t, nil
+}
+
+// parseClock consumes a time of day: "noon", "midnight" (the start of the
+// day), or a clock such as "9am" or "14:30", optionally after "at"
+func (p *parser) parseClock() (bool, error) {
+	n := 0
+	if p.peek(0) == "at" {
+		n = 1
+	}
+	token := p.peek(n)
+	var hour, minute, sec, ns int
+	switch token {
+	case "noon":
+		hour = 12
+	case "midnight":
+	default:
+		// "9 am" and "11:00 pm" are one clock split across two tokens
+		if next := p.peek(n + 1); clockRegexp.MatchString(token+next) && strings.Trim(next, "apm.") == "" && next != "" {
+			token += next
+			n++
+		}
+		m := clockRegexp.FindStringSubmatch(token)
+		if m == nil || (m[2] == "" && m[5] == "") {
+			if n > 0 {
+				return false, p.errorf("expected a time of day after \"at\", not %q", p.peek(n))
+			}
+			return false, nil
+		}
+		hour, _ = strconv.Atoi(m[1])
+		minute, _ = strconv.Atoi(m[2])
+		sec, _ = strconv.Atoi(m[3])
+		if m[4] != "" {
+			ns, _ = strconv.Atoi(m[4] + strings.Repeat("0", 9-len(m[4])))
+		}
+		if meridiem := m[5]; meridiem != "" {
+			if hour < 1 || hour > 12 {
+				return false, p.errorf("hour %d is out of range for a 12-hour clock", hour)
+			}
+			hour %= 12
+			if strings.HasPrefix(meridiem, "p") {
+				hour += 12
+			}
+		}
+		if hour > 23 || minute > 59 || sec > 59 {
+			return false, p.errorf("time of day %q is out of range", token)
+		}
+	}
+	if p.hasClock {
+		return false, p.errorf("more than one time of day")
+	}
+	p.pos += n + 1
+	p.hasClock = true
+	p.hour, p.minute, p.sec, p.ns = hour, minute, sec, ns
+	clock := fmt.Sprintf("%02d:%02d", hour, minute)
+	if sec != 0 || ns != 0 {
+		clock += fmt.Sprintf(":%02d", sec)
+	}
+	if ns != 0 {
+		clock += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+	}
+	p.phrases = append(p.phrases, "at "+clock)
+	return true, nil
+}
+
+// parseShift consumes "in 2 weeks", "3 days ago", or "2 hours from now";
+// several amounts may be combined, as in "1 hour and 30 minutes ago"
+func (p *parser) parseShift() (bool, error) {
+	in := p.peek(0) == "in"
+	if !in && !(isAmount(p.peek(0)) && units[p.peek(1)].name != "") {
+		return false, nil
+	}
+	if p.hasShift {
+		return false, p.errorf("more than one shift such as \"3 days ago\" or \"in 2 weeks\"")
+	}
+	if in {
+		p.pos++
+	}
+	var years, months, days int
+	var elapsed time.Duration
+	var parts []string
+	for {
+		amount, name := p.peek(0), p.peek(1)
+		u, isUnit := units[name]
+		if !isAmount(amount) || !isUnit {
+			break
+		}
+		n := 1
+		if amount != "a" && amount != "an" {
+			n, _ = strconv.Atoi(amount)
+		}
+		if n < 0 || n > maxAmount {
+			return false, p.errorf("amount %s must be between 0 and %d", amount, maxAmount)
+		}
+		years += n * u.years
+		months += n * u.months
+		days += n * u.days
+		elapsed += time.Duration(n) * u.elapsed
+		label := u.name
+		if n != 1 {
+			label += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, label))
+		p.pos += 2
+		if p.peek(0) == "and" && isAmount(p.peek(1)) {
+			p.pos++
+		}
+	}
+	if len(parts) == 0 {
+		return false, p.errorf("expected an amount and unit after \"in\", such as \"in 2 weeks\"")
+	}
+	sign := 1
+	switch {
+	case in:
+		p.phrases = append(p.phrases, "in "+strings.Join(parts, " "))
+	case p.peek(0) == "ago":
+		sign = -1
+		p.pos++
+		p.phrases = append(p.phrases, strings.Join(parts, " ")+" ago")
+	case p.peek(0) == "from" && p.peek(1) == "now":
+		p.pos += 2
+		p.phrases = append(p.phrases, strings.Join(parts, " ")+" from now")
+	case p.peek(0) == "later":
+		p.pos++
+		p.phrases = append(p.phrases, strings.Join(parts, " ")+" from now")
+	default:
+		return false, p.errorf("expected \"ago\" or \"from now\" after %q", strings.Join(parts, " "))
+	}
+	p.hasShift = true
+	p.years, p.months, p.days = sign*years, sign*months, sign*days
+	p.elapsed = time.Duration(sign) * elapsed
+	return true, nil
+}
+
+// parseAnchor consumes the day or period the phrase starts from
+func (p *parser) parseAnchor() (bool, error) {
+	token := p.peek(0)
+	var anchor time.Time
+	var phrase string
+	var n int
+	var err error
+	_, isWeekday := weekdays[token]
+	switch {
+	case token == "now" || token == "today":
+		anchor, phrase, n = p.ref, token, 1
+	case token == "tomorrow" || token == "yesterday":
+		anchor, phrase, n = p.ref.AddDate(0, 0, 1), token, 1
+		if token == "yesterday" {
+			anchor = p.ref.AddDate(0, 0, -1)
+		}
+	case token == "beginning" || token == "start" || token == "end":
+		anchor, phrase, n, err = p.boundary()
+	case ordinals[token] != 0 && p.peek(2) == "of":
+		anchor, phrase, n, err = p.nthWeekdayOfMonth()
+	case isWeekday:
+		anchor, phrase, n = p.weekday(0, weekdays[token]), "this "+weekdays[token].String(), 1
+	default:
+		relative, isRelative := relatives[token]
+		if !isRelative {
+			return false, nil
+		}
+		next := p.peek(1)
+		if day, isWeekday := weekdays[next]; isWeekday {
+			anchor, phrase, n = p.weekday(relative, day), fmt.Sprintf("%s %s", relativeName(relative), day), 2
+		} else if periods[next] {
+			u := units[next]
+			anchor = p.ref.AddDate(relative*u.years, relative*u.months, relative*u.days)
+			phrase, n = fmt.Sprintf("%s %s", relativeName(relative), next), 2
+		} else {
+			return false, p.errorf("expected a weekday, day, week, month, quarter, or year after %q", token)
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+	if p.hasAnchor {
+		return false, p.errorf("more than one day such as \"tomorrow\" or \"next friday\"")
+	}
+	p.pos += n
+	p.hasAnchor, p.anchor = true, anchor
+	p.phrases = append(p.phrases, strings.ToLower(phrase))
+	return true, nil
+}
+
+func relativeName(relative int) string {
+	switch relative {
+	case 1:
+		return "next"
+	case -1:
+		return "last"
+	}
+	return "this"
+}
+
+// weekday returns midnight on the weekday: for relative 0 the first one on
+// or after the reference day, for 1 the first one after it, and for -1 the
+// last one before it
+func (p *parser) weekday(relative int, day time.Weekday) time.Time {
+	today, _ := datecalc.StartOf(p.ref, "day")
+	ahead := (int(day) - int(today.Weekday()) + 7) % 7
+	switch relative {
+	case 1:
+		if ahead == 0 {
+			ahead = 7
+		}
+	case -1:
+		ahead -= 7
+	}
+	return today.AddDate(0, 0, ahead)
+}
+
+// boundary consumes "beginning of next quarter", "start of the week", or
+// "end of month": the first instant of a period, or its last nanosecond
+func (p *parser) boundary() (time.Time, string, int, error) {
+	edge := p.peek(0)
+	if edge == "start" {
+		edge = "beginning"
+	}
+	n := 1
+	if p.peek(n) != "of" {
+		return time.Time{}, "", 0, p.errorf("expected \"of\" after %q", p.peek(0))
+	}
+	n++
+	if p.peek(n) == "the" {
+		n++
+	}
+	relative, period := 0, p.peek(n)
+	switch period {
+	case "today":
+		period = "day"
+	case "tomorrow":
+		relative, period = 1, "day"
+	case "yesterday":
+		relative, period = -1, "day"
+	default:
+		if r, isRelative := relatives[period]; isRelative {
+			relative = r
+			n++
+			period = p.peek(n)
+		}
+		if !periods[period] {
+			return time.Time{}, "", 0, p.errorf("expected a day, week, month, quarter, or year after %q", strings.Join(p.tokens[p.pos:p.pos+n], " "))
+		}
+	}
+	// snap before shifting so "next month" from January 31 is February, not
+	// the March that AddDate would overflow into
+	start, _ := datecalc.StartOf(p.ref, period)
+	start, _ = datecalc.Apply(start, period, relative, 1)
+	phrase := fmt.Sprintf("%s of %s %s", edge, relativeName(relative), period)
+	if edge == "end" {
+		end, _ := datecalc.EndOf(start, period)
+		return end, phrase, n + 1, nil
+	}
+	return start, phrase, n + 1, nil
+}
+
+// nthWeekdayOfMonth consumes "last monday of march", "first friday of next
+// month", or "2nd tuesday of november 2027"; a month named without a year
+// is in the reference year
+func (p *parser) nthWeekdayOfMonth() (time.Time, string, int, error) {
+	nth := ordinals[p.peek(0)]
+	day, isWeekday := weekdays[p.peek(1)]
+	if !isWeekday {
+		return time.Time{}, "", 0, p.errorf("expected a weekday after %q", p.peek(0))
+	}
+	year, month := p.ref.Year(), p.ref.Month()
+	n := 3
+	target := p.peek(n)
+	if m, isMonth := months[target]; isMonth {
+		month = m
+		n++
+		if y, err := strconv.Atoi(p.peek(n)); err == nil && len(p.peek(n)) == 4 {
+			year = y
+			n++
+		}
+	} else if relative, isRelative := relatives[target]; isRelative && p.peek(n+1) == "month" {
+		first := time.Date(year, month+time.Month(relative), 1, 0, 0, 0, 0, time.UTC)
+		year, month = first.Year(), first.Month()
+		n += 2
+	} else if target == "month" || (target == "the" && p.peek(n+1) == "month") {
+		n++
+		if target == "the" {
+			n++
+		}
+	} else {
+		return time.Time{}, "", 0, p.errorf("expected a month after %q", strings.Join(p.tokens[p.pos:p.pos+3], " "))
+	}
+
+	loc := p.ref.Location()
+	var t time.Time
+	if nth > 0 {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+		t = first.AddDate(0, 0, (int(day)-int(first.Weekday())+7)%7+7*(nth-1))
+		if t.Month() != month {
+			return time.Time{}, "", 0, p.errorf("%s %d has no %s %s", month, year, ordinalNames[nth], day)
+		}
+	} else {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+		t = last.AddDate(0, 0, -((int(last.Weekday()) - int(day) + 7) % 7))
+	}
+	return t, fmt.Sprintf("%s %s of %s %d", ordinalNames[nth], day, month, year), n, nil
+}
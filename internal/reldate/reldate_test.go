@@ -0,0 +1,130 @@
+package reldate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// reference is Sunday, October 18, 2026 at 14:30 in New York
+func reference(t *testing.T) time.Time {
+	t.Helper()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return time.Date(2026, 10, 18, 14, 30, 0, 0, loc)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	ref := reference(t)
+	tests := []struct {
+		source string
+		want   string
+		phrase string
+	}{
+		{"3 days ago", "2026-10-15 14:30:00 EDT", "3 days ago"},
+		{"in 2 weeks", "2026-11-01 14:30:00 EST", "in 2 weeks"},
+		{"an hour ago", "2026-10-18 13:30:00 EDT", "1 hour ago"},
+		{"1 hour and 30 minutes ago", "2026-10-18 13:00:00 EDT", "1 hour 30 minutes ago"},
+		{"2 hours from now", "2026-10-18 16:30:00 EDT", "2 hours from now"},
+		{"next friday", "2026-10-23 00:00:00 EDT", "next friday"},
+		{"Friday", "2026-10-23 00:00:00 EDT", "this friday"},
+		{"this sunday", "2026-10-18 00:00:00 EDT", "this sunday"},
+		{"next sunday", "2026-10-25 00:00:00 EDT", "next sunday"},
+		{"last sunday", "2026-10-11 00:00:00 EDT", "last sunday"},
+		{"last monday", "2026-10-12 00:00:00 EDT", "last monday"},
+		{"next week", "2026-10-25 14:30:00 EDT", "next week"},
+		{"last monday of march", "2026-03-30 00:00:00 EDT", "last monday of march 2026"},
+		{"first friday of next month", "2026-11-06 00:00:00 EST", "first friday of november 2026"},
+		{"2nd Tuesday of November 2027", "2027-11-09 00:00:00 EST", "second tuesday of november 2027"},
+		{"last friday of the month", "2026-10-30 00:00:00 EDT", "last friday of october 2026"},
+		{"tomorrow 9am", "2026-10-19 09:00:00 EDT", "tomorrow at 09:00"},
+		{"9 am tomorrow", "2026-10-19 09:00:00 EDT", "at 09:00 tomorrow"},
+		{"next friday at 3:30 pm", "2026-10-23 15:30:00 EDT", "next friday at 15:30"},
+		{"3 days ago at noon", "2026-10-15 12:00:00 EDT", "3 days ago at 12:00"},
+		{"yesterday 14:30:15.25", "2026-10-17 14:30:15.25 EDT", "yesterday at 14:30:15.25"},
+		{"noon", "2026-10-18 12:00:00 EDT", "at 12:00"},
+		{"midnight", "2026-10-18 00:00:00 EDT", "at 00:00"},
+		{"9am", "2026-10-18 09:00:00 EDT", "at 09:00"},
+		{"9:30pm", "2026-10-18 21:30:00 EDT", "at 21:30"},
+		{"end of month", "2026-10-31 23:59:59.999999999 EDT", "end of this month"},
+		{"beginning of next quarter", "2027-01-01 00:00:00 EST", "beginning of next quarter"},
+		{"start of the week", "2026-10-12 00:00:00 EDT", "beginning of this week"},
+		{"end of last year", "2025-12-31 23:59:59.999999999 EST", "end of last year"},
+		{"beginning of tomorrow", "2026-10-19 00:00:00 EDT", "beginning of next day"},
+	}
+	for _, tt := range tests {
+		got, phrase, claimed, err := Parse(tt.source, ref)
+		if err != nil || !claimed {
+			t.Errorf("Parse(%q) = %v, %v; want %s", tt.source, claimed, err, tt.want)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05.999999999 MST"); s != tt.want || phrase != tt.phrase {
+			t.Errorf("Parse(%q) = %s, %q; want %s, %q", tt.source, s, phrase, tt.want, tt.phrase)
+		}
+	}
+}
+
+func TestParseRelativeDaysAcrossDST(t *testing.T) {
+	t.Parallel()
+	// on the day DST starts, yesterday keeps the wall clock like 1 day ago
+	ref := reference(t)
+	ref = time.Date(2026, 3, 8, 12, 0, 0, 0, ref.Location())
+	for word, shift := range map[string]string{"yesterday": "1 day ago", "tomorrow": "1 day from now"} {
+		got, _, _, err := Parse(word, ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _, _, err := Parse(shift, ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("Parse(%q) = %v, want %v, as %q", word, got, want, shift)
+		}
+	}
+	if got, _, _, _ := Parse("yesterday", ref); got.Format("2006-01-02 15:04 MST") != "2026-03-07 12:00 EST" {
+		t.Errorf("Parse(yesterday) = %v, want 2026-03-07 12:00 EST", got)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+	ref := reference(t)
+	tests := map[string]string{
+		"next fridya":                  `expected a weekday, day, week, month, quarter, or year after "next"`,
+		"in 2 weeks ago":               `understood "in 2 weeks" but not "ago"`,
+		"3 days":                       `expected "ago" or "from now" after "3 days"`,
+		"in soon":                      `expected an amount and unit after "in"`,
+		"at 25:00":                     "out of range",
+		"at 13pm":                      "out of range for a 12-hour clock",
+		"at nine":                      `expected a time of day after "at"`,
+		"tomorrow yesterday":           "more than one day",
+		"noon midnight":                "more than one time of day",
+		"2 days ago in 3 days":         "more than one shift",
+		"fifth monday of february":     "February 2026 has no fifth Monday",
+		"last monday of smarch":        `expected a month after "last monday of"`,
+		"end of decade":                `expected a day, week, month, quarter, or year after "end of"`,
+		"end month":                    `expected "of" after "end"`,
+		"in 2000000 hours":             "must be between 0 and 1000000",
+		"beginning of the next moment": `after "beginning of the next"`,
+	}
+	for source, want := range tests {
+		_, _, claimed, err := Parse(source, ref)
+		if !claimed || err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) = %v, %v; want a claimed error mentioning %q", source, claimed, err, want)
+		}
+	}
+}
+
+func TestParseUnclaimed(t *testing.T) {
+	t.Parallel()
+	ref := reference(t)
+	for _, source := range []string{"", "2026-10-18", "Jan 2, 2024", "Mon Jan 2 15:04:05 2006", "friday the 13th", "02 Jan 06 15:04 MST"} {
+		if _, _, claimed, err := Parse(source, ref); claimed {
+			t.Errorf("Parse(%q) claimed it, err = %v", source, err)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+)
+
+// ErrUnbounded is returned when expanding a rule without COUNT or UNTIL
+// and without a caller-supplied bound
+var ErrUnbounded = errors.New("recurrence has no end: give COUNT or UNTIL in the rule, or a limit")
+
+// maxEmptyPeriods bounds the consecutive periods without an occurrence
+// Expand will step over, so a rule that can never match, such as
+// FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30, fails instead of looping forever
+const maxEmptyPeriods = 1_000_000
+
+// maxYear is the last year Expand generates occurrences in
+const maxYear = 9999
+
+// Options bound and filter an expansion. Until, when non-zero, is the
+// latest occurrence returned; Limit, when positive, caps the number of
+// occurrences returned. ExDates are excluded instants and ExDays excluded
+// days, compared by date in the start's location; excluded occurrences
+// still count toward the rule's COUNT.
+type Options struct {
+	Until   time.Time
+	Limit   int
+	ExDates []time.Time
+	ExDays  []time.Time
+}
+
+// Expand returns the rule's occurrences at or after start, in start's
+// location. Start anchors the rule: it supplies the defaults for parts the
+// rule omits (the month and day for FREQ=YEARLY, the day for MONTHLY, the
+// weekday for WEEKLY, and the time of day), and the periods INTERVAL
+// counts. As in RFC 5545, start itself is an occurrence only when it
+// matches the rule. A wall-clock time that falls in a DST gap moves
+// forward by the gap's length; one that occurs twice is the first.
+func (r *Rule) Expand(start time.Time, opts Options) ([]time.Time, error) {
+	if !r.Bounded() && opts.Until.IsZero() && opts.Limit <= 0 {
+		return nil, ErrUnbounded
+	}
+	loc := start.Location()
+	until := opts.Until
+	if !r.Until.IsZero() {
+		ruleUntil := r.Until
+		if r.UntilFloating {
+			ruleUntil = time.Date(ruleUntil.Year(), ruleUntil.Month(), ruleUntil.Day(),
+				ruleUntil.Hour(), ruleUntil.Minute(), ruleUntil.Second(), ruleUntil.Nanosecond(), loc)
+		}
+		if until.IsZero() || ruleUntil.Before(until) {
+			until = ruleUntil
+		}
+	}
+	exDates := make(map[int64]bool)
+	for _, t := range opts.ExDates {
+		exDates[t.UnixNano()] = true
+	}
+	exDays := make(map[time.Time]bool)
+	for _, t := range opts.ExDays {
+		exDays[civilDate(t.In(loc))] = true
+	}
+
+	var occurrences []time.Time
+	produced, empty := 0, 0
+	for k := 0; ; k++ {
+		candidates, ok := r.period(start, k)
+		if !ok {
+			if produced == 0 {
+				return nil, fmt.Errorf("recurrence has no occurrence between %s and the year %d", start.Format(time.DateOnly), maxYear)
+			}
+			return occurrences, nil
+		}
+		if len(candidates) == 0 {
+			if empty++; empty > maxEmptyPeriods {
+				return nil, fmt.Errorf("recurrence has no occurrence within %d periods of %s", maxEmptyPeriods, start.Format(time.DateOnly))
+			}
+			continue
+		}
+		empty = 0
+		for _, t := range candidates {
+			if t.Before(start) {
+				continue
+			}
+			if !until.IsZero() && t.After(until) {
+				return occurrences, nil
+			}
+			produced++
+			if !exDates[t.UnixNano()] && !exDays[civilDate(t)] {
+				occurrences = append(occurrences, t)
+				if opts.Limit > 0 && len(occurrences) >= opts.Limit {
+					return occurrences, nil
+				}
+			}
+			if r.Count > 0 && produced >= r.Count {
+				return occurrences, nil
+			}
+		}
+	}
+}
+
+// period returns the sorted occurrences in the kth period after the one
+// containing start, with BYSETPOS applied; ok is false past maxYear
+func (r *Rule) period(start time.Time, k int) (occurrences []time.Time, ok bool) {
+	step := k * r.Interval
+	if r.Freq < Daily {
+		return r.subDailyPeriod(start, step)
+	}
+	y, m, d := start.Date()
+	var first time.Time
+	var days int
+	switch r.Freq {
+	case Yearly:
+		first = time.Date(y+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		days = daysInYear(first.Year())
+	case Monthly:
+		first = time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		days = datecalc.DaysIn(first.Year(), first.Month())
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		first = time.Date(y, m, d-offset+7*step, 0, 0, 0, 0, time.UTC)
+		days = 7
+	default:
+		first = time.Date(y, m, d+step, 0, 0, 0, 0, time.UTC)
+		days = 1
+	}
+	if first.Year() > maxYear {
+		return nil, false
+	}
+	hours := orDefault(r.ByHour, start.Hour())
+	minutes := orDefault(r.ByMinute, start.Minute())
+	seconds := orDefault(r.BySecond, start.Second())
+	for i := 0; i < days; i++ {
+		day := first.AddDate(0, 0, i)
+		if !r.matchDay(day, start) {
+			continue
+		}
+		for _, h := range hours {
+			for _, mi := range minutes {
+				for _, s := range seconds {
+					occurrences = append(occurrences, wallTime(day, h, mi, s, start.Nanosecond(), start.Location()))
+				}
+			}
+		}
+	}
+	return r.setPos(occurrences), true
+}
+
+// subDailyPeriod returns the occurrences in an hourly, minutely, or
+// secondly period; these periods step in elapsed time rather than on the
+// wall clock, so FREQ=HOURLY visits both 01:00s on a day that repeats one
+func (r *Rule) subDailyPeriod(start time.Time, step int) ([]time.Time, bool) {
+	var unit time.Duration
+	var base time.Time
+	trim := time.Duration(start.Nanosecond())
+	switch r.Freq {
+	case Hourly:
+		unit = time.Hour
+		trim += time.Duration(start.Minute())*time.Minute + time.Duration(start.Second())*time.Second
+	case Minutely:
+		unit = time.Minute
+		trim += time.Duration(start.Second()) * time.Second
+	default:
+		unit = time.Second
+	}
+	base = start.Add(-trim).Add(time.Duration(step) * unit)
+	if base.Year() > maxYear {
+		return nil, false
+	}
+	if !r.matchDay(civilDate(base), start) || !matchInt(r.ByHour, base.Hour()) {
+		return nil, true
+	}
+	minutes, seconds := []int{0}, []int{0}
+	switch r.Freq {
+	case Hourly:
+		minutes = orDefault(r.ByMinute, start.Minute())
+		seconds = orDefault(r.BySecond, start.Second())
+	case Minutely:
+		if !matchInt(r.ByMinute, base.Minute()) {
+			return nil, true
+		}
+		seconds = orDefault(r.BySecond, start.Second())
+	default:
+		if !matchInt(r.ByMinute, base.Minute()) || !matchInt(r.BySecond, base.Second()) {
+			return nil, true
+		}
+	}
+	var occurrences []time.Time
+	for _, mi := range minutes {
+		for _, s := range seconds {
+			offset := time.Duration(mi)*time.Minute + time.Duration(s)*time.Second + time.Duration(start.Nanosecond())
+			occurrences = append(occurrences, base.Add(offset))
+		}
+	}
+	return r.setPos(occurrences), true
+}
+
+// matchDay reports whether the civil date day passes the rule's BYMONTH,
+// BYYEARDAY, BYMONTHDAY, and BYDAY parts; for YEARLY, MONTHLY, and WEEKLY
+// rules without day parts, the date must also match start's month and
+// day, day, or weekday respectively
+func (r *Rule) matchDay(day, start time.Time) bool {
+	if !matchInt(r.ByMonth, int(day.Month())) {
+		return false
+	}
+	if len(r.ByYearDay) > 0 {
+		yd := day.YearDay()
+		if !slices.Contains(r.ByYearDay, yd) && !slices.Contains(r.ByYearDay, yd-daysInYear(day.Year())-1) {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 {
+		md := day.Day()
+		if !slices.Contains(r.ByMonthDay, md) && !slices.Contains(r.ByMonthDay, md-datecalc.DaysIn(day.Year(), day.Month())-1) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 && !r.matchWeekday(day) {
+		return false
+	}
+	if len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) > 0 {
+		return true
+	}
+	switch r.Freq {
+	case Yearly:
+		return (len(r.ByMonth) > 0 || day.Month() == start.Month()) && day.Day() == start.Day()
+	case Monthly:
+		return day.Day() == start.Day()
+	case Weekly:
+		return day.Weekday() == start.Weekday()
+	}
+	return true
+}
+
+// matchWeekday reports whether day matches a BYDAY entry; ordinals count
+// within the month for MONTHLY rules and YEARLY rules with BYMONTH, and
+// within the year for other YEARLY rules
+func (r *Rule) matchWeekday(day time.Time) bool {
+	inYear := r.Freq == Yearly && len(r.ByMonth) == 0
+	for _, entry := range r.ByDay {
+		if entry.Weekday != day.Weekday() {
+			continue
+		}
+		if entry.N == 0 {
+			return true
+		}
+		index, length := day.Day(), datecalc.DaysIn(day.Year(), day.Month())
+		if inYear {
+			index, length = day.YearDay(), daysInYear(day.Year())
+		}
+		if entry.N > 0 && (index-1)/7+1 == entry.N {
+			return true
+		}
+		if entry.N < 0 && -((length-index)/7+1) == entry.N {
+			return true
+		}
+	}
+	return false
+}
+
+// setPos sorts a period's occurrences, drops duplicates from DST gap
+// normalization, and keeps the BYSETPOS positions when the rule has them
+func (r *Rule) setPos(occurrences []time.Time) []time.Time {
+	slices.SortFunc(occurrences, func(a, b time.Time) int { return a.Compare(b) })
+	occurrences = slices.CompactFunc(occurrences, func(a, b time.Time) bool { return a.Equal(b) })
+	if len(r.BySetPos) == 0 {
+		return occurrences
+	}
+	var selected []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(occurrences) + pos
+		}
+		if i >= 0 && i < len(occurrences) {
+			selected = append(selected, occurrences[i])
+		}
+	}
+	slices.SortFunc(selected, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(selected, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+// matchInt reports whether n is in list, or list is empty
+func matchInt(list []int, n int) bool {
+	return len(list) == 0 || slices.Contains(list, n)
+}
+
+// orDefault returns list, sorted, or the single value def when it is empty
+func orDefault(list []int, def int) []int {
+	if len(list) == 0 {
+		return []int{def}
+	}
+	return slices.Sorted(slices.Values(list))
+}
+
+// wallTime returns the instant showing the given date and time on loc's
+// wall clock, resolved as RFC 5545 requires: a time that occurs twice is
+// the first, and a time skipped by a DST gap uses the offset in effect
+// before the gap, so 02:30 on a spring-forward night is 03:30. time.Date
+// alone leaves both cases to the zone's offsets.
+func wallTime(day time.Time, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	wall := time.Date(day.Year(), day.Month(), day.Day(), hour, min, sec, nsec, time.UTC)
+	_, before := wall.Add(-26 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(26 * time.Hour).In(loc).Zone()
+	var first time.Time
+	for _, offset := range []int{before, after} {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, got := t.Zone(); got != offset {
+			continue
+		}
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+	}
+	if first.IsZero() {
+		return wall.Add(-time.Duration(before) * time.Second).In(loc)
+	}
+	return first
+}
+
+// civilDate returns t's date as midnight UTC
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysInYear returns 366 for leap years and 365 otherwise
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
@@ -0,0 +1,420 @@
+// Package rrule parses and expands RFC 5545 recurrence rules: FREQ,
+// INTERVAL, COUNT, UNTIL, BYMONTH, BYYEARDAY, BYMONTHDAY, BYDAY (with
+// ordinals such as -1FR), BYHOUR, BYMINUTE, BYSECOND, BYSETPOS, and WKST,
+// plus the DTSTART and EXDATE properties that accompany a rule in an
+// iCalendar component. BYWEEKNO is not supported. Occurrences are computed
+// on the wall clock of the start's location, so a 09:00 meeting stays at
+// 09:00 across DST changes.
+package rrule
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Freq is a rule's base frequency.
+type Freq int
+
+const (
+	Secondly Freq = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+// freqNames maps FREQ values to Freq
+var freqNames = map[string]Freq{
+	"SECONDLY": Secondly,
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+	"YEARLY":   Yearly,
+}
+
+// weekdayCodes maps the two-letter BYDAY and WKST codes to time.Weekday
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// WeekdayNum is one BYDAY entry: a weekday and an optional ordinal, where
+// N=0 means every such weekday, N=2 the second, and N=-1 the last.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed RRULE. Until is zero when absent; UntilFloating records
+// an UNTIL without a trailing Z, whose wall clock is read in the start's
+// location.
+type Rule struct {
+	Freq          Freq
+	Interval      int
+	Count         int
+	Until         time.Time
+	UntilFloating bool
+	ByMonth       []int
+	ByYearDay     []int
+	ByMonthDay    []int
+	ByDay         []WeekdayNum
+	ByHour        []int
+	ByMinute      []int
+	BySecond      []int
+	BySetPos      []int
+	WeekStart     time.Weekday
+}
+
+// Bounded reports whether the rule ends by itself, through COUNT or UNTIL.
+func (r *Rule) Bounded() bool {
+	return r.Count > 0 || !r.Until.IsZero()
+}
+
+// Parse parses an RRULE value such as "FREQ=MONTHLY;BYDAY=-1FR"; a leading
+// "RRULE:" is accepted. Part names and values are case-insensitive.
+func Parse(rule string) (*Rule, error) {
+	original := rule
+	rule = strings.TrimSpace(rule)
+	if len(rule) >= 6 && strings.EqualFold(rule[:6], "RRULE:") {
+		rule = rule[6:]
+	}
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	hasFreq := false
+	for _, part := range strings.Split(rule, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !found || value == "" {
+			return nil, fmt.Errorf("invalid RRULE %q: expected NAME=VALUE, not %q", original, part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("invalid RRULE %q: %s is given twice", original, key)
+		}
+		seen[key] = true
+		var err error
+		switch key {
+		case "FREQ":
+			var ok bool
+			if r.Freq, ok = freqNames[value]; !ok {
+				err = fmt.Errorf("unknown FREQ %q", value)
+			}
+			hasFreq = true
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval < 1 {
+				err = fmt.Errorf("INTERVAL must be a positive integer: %q", value)
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count < 1 {
+				err = fmt.Errorf("COUNT must be a positive integer: %q", value)
+			}
+		case "UNTIL":
+			r.Until, r.UntilFloating, err = parseUntil(value)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(key, value, 1, 12, false)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseInts(key, value, 1, 366, true)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(key, value, 1, 31, true)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(key, value, 0, 23, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(key, value, 0, 59, false)
+		case "BYSECOND":
+			r.BySecond, err = parseInts(key, value, 0, 59, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(key, value, 1, 366, true)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "WKST":
+			var ok bool
+			if r.WeekStart, ok = weekdayCodes[value]; !ok {
+				err = fmt.Errorf("invalid WKST %q: expected a day code such as MO", value)
+			}
+		case "BYWEEKNO":
+			err = fmt.Errorf("BYWEEKNO is not supported")
+		default:
+			err = fmt.Errorf("unknown part %s", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE %q: %w", original, err)
+		}
+	}
+	if !hasFreq {
+		return nil, fmt.Errorf("invalid RRULE %q: FREQ is required", original)
+	}
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("invalid RRULE %q: %w", original, err)
+	}
+	return r, nil
+}
+
+// validate applies RFC 5545's restrictions on combining parts
+func (r *Rule) validate() error {
+	if r.Count > 0 && !r.Until.IsZero() {
+		return fmt.Errorf("COUNT and UNTIL cannot both be given")
+	}
+	for _, day := range r.ByDay {
+		if day.N != 0 && r.Freq != Monthly && r.Freq != Yearly {
+			return fmt.Errorf("BYDAY ordinals such as %d%s need FREQ=MONTHLY or FREQ=YEARLY", day.N, dayCode(day.Weekday))
+		}
+		if day.N < -53 || day.N > 53 || (r.Freq == Monthly && (day.N < -5 || day.N > 5)) {
+			return fmt.Errorf("BYDAY ordinal %d is out of range", day.N)
+		}
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == Weekly {
+		return fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	if len(r.ByYearDay) > 0 && (r.Freq == Daily || r.Freq == Weekly || r.Freq == Monthly) {
+		return fmt.Errorf("BYYEARDAY cannot be used with FREQ=DAILY, WEEKLY, or MONTHLY")
+	}
+	if len(r.BySetPos) > 0 && len(r.ByMonth)+len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay)+
+		len(r.ByHour)+len(r.ByMinute)+len(r.BySecond) == 0 {
+		return fmt.Errorf("BYSETPOS needs another BYxxx part to select from")
+	}
+	return nil
+}
+
+// parseUntil parses an UNTIL value: a UTC date-time ending in Z, a
+// floating date-time, or a date, which includes that whole day
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Nanosecond), true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid UNTIL %q: expected YYYYMMDD, YYYYMMDDTHHMMSS, or YYYYMMDDTHHMMSSZ", value)
+}
+
+// parseInts parses a comma-separated list of integers within [min, max],
+// or within [-max, -min] as well when negative is true
+func parseInts(key, value string, min, max int, negative bool) ([]int, error) {
+	var list []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(item), "+"))
+		abs := n
+		if negative && n < 0 {
+			abs = -n
+		}
+		if err != nil || abs < min || abs > max {
+			if negative {
+				return nil, fmt.Errorf("%s values must be %d to %d or -%d to -%d: %q", key, min, max, max, min, item)
+			}
+			return nil, fmt.Errorf("%s values must be %d to %d: %q", key, min, max, item)
+		}
+		if !slices.Contains(list, n) {
+			list = append(list, n)
+		}
+	}
+	return list, nil
+}
+
+// parseByDay parses a BYDAY list such as "MO,WE,FR" or "2TU,-1FR"
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var list []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		code := item[len(item)-2:]
+		weekday, ok := weekdayCodes[code]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q: expected a day code such as MO, 2TU, or -1FR", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(strings.TrimPrefix(prefix, "+")); err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid BYDAY ordinal %q", item)
+			}
+		}
+		list = append(list, WeekdayNum{N: n, Weekday: weekday})
+	}
+	return list, nil
+}
+
+// dayCode returns the two-letter code of a weekday
+func dayCode(day time.Weekday) string {
+	return strings.ToUpper(day.String()[:2])
+}
+
+// Set is a rule with the DTSTART and EXDATE properties that accompany it
+// in an iCalendar component. Start is zero when no DTSTART was given.
+// ExDates are excluded instants; ExDays are excluded whole days, from
+// EXDATE;VALUE=DATE, as midnight in Location.
+type Set struct {
+	Rule     *Rule
+	Start    time.Time
+	Location *time.Location
+	ExDates  []time.Time
+	ExDays   []time.Time
+}
+
+// ParseSet parses iCalendar recurrence text: an RRULE value alone, or
+// lines of DTSTART, RRULE, and EXDATE properties, such as
+//
+//	DTSTART;TZID=America/New_York:20260105T090000
+//	RRULE:FREQ=WEEKLY;BYDAY=MO
+//	EXDATE;TZID=America/New_York:20260119T090000
+//
+// A TZID sets the set's Location; floating date-times are read in the
+// TZID's location, or in loc when there is none.
+func ParseSet(text string, loc *time.Location) (*Set, error) {
+	set := &Set{Location: loc}
+	var exdates []string
+	for _, line := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(line, ":")
+		name, _, _ = strings.Cut(strings.ToUpper(name), ";")
+		switch name {
+		case "DTSTART":
+			start, tzid, err := parseDateProperty(line)
+			if err != nil {
+				return nil, err
+			}
+			if tzid != nil {
+				set.Location = tzid
+			}
+			set.Start = start
+		case "EXDATE":
+			exdates = append(exdates, line)
+		case "RRULE":
+			if set.Rule != nil {
+				return nil, fmt.Errorf("only one RRULE is supported")
+			}
+			rule, err := Parse(line)
+			if err != nil {
+				return nil, err
+			}
+			set.Rule = rule
+		default:
+			if !strings.Contains(line, "=") || strings.Contains(name, ":") {
+				return nil, fmt.Errorf("unsupported recurrence line %q: expected DTSTART, RRULE, or EXDATE", line)
+			}
+			if set.Rule != nil {
+				return nil, fmt.Errorf("only one RRULE is supported")
+			}
+			rule, err := Parse(line)
+			if err != nil {
+				return nil, err
+			}
+			set.Rule = rule
+		}
+	}
+	if set.Rule == nil {
+		return nil, fmt.Errorf("no RRULE found in %q", text)
+	}
+	// a DTSTART's TZID governs floating values wherever the lines appear
+	if !set.Start.IsZero() {
+		set.Start = rezone(set.Start, set.Location)
+	}
+	for _, line := range exdates {
+		if err := set.addExDates(line); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// floatingZone marks a date-time parsed without a zone, whose wall clock
+// is later read in the set's location
+var floatingZone = time.FixedZone("floating", 0)
+
+// rezone reads a floating date-time's wall clock in loc
+func rezone(t time.Time, loc *time.Location) time.Time {
+	if t.Location() != floatingZone {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// parseDateProperty parses a DTSTART line's value and its TZID parameter
+func parseDateProperty(line string) (time.Time, *time.Location, error) {
+	params, value, _ := strings.Cut(line, ":")
+	var loc *time.Location
+	for _, param := range strings.Split(params, ";")[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		if strings.EqualFold(key, "TZID") {
+			var err error
+			if loc, err = time.LoadLocation(strings.Trim(val, `"`)); err != nil {
+				return time.Time{}, nil, fmt.Errorf("invalid TZID %q in %q", val, line)
+			}
+		}
+	}
+	t, err := parseDateValue(value, loc)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid date in %q: %w", line, err)
+	}
+	return t, loc, nil
+}
+
+// parseDateValue parses an iCalendar DATE or DATE-TIME value; without loc,
+// a value lacking a trailing Z is floating
+func parseDateValue(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if loc == nil {
+		loc = floatingZone
+	}
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected YYYYMMDD, YYYYMMDDTHHMMSS, or YYYYMMDDTHHMMSSZ: %q", value)
+}
+
+// addExDates adds the comma-separated values of an EXDATE line; VALUE=DATE
+// values exclude whole days
+func (s *Set) addExDates(line string) error {
+	params, values, _ := strings.Cut(line, ":")
+	loc := s.Location
+	days := false
+	for _, param := range strings.Split(params, ";")[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		switch strings.ToUpper(key) {
+		case "TZID":
+			var err error
+			if loc, err = time.LoadLocation(strings.Trim(val, `"`)); err != nil {
+				return fmt.Errorf("invalid TZID %q in %q", val, line)
+			}
+		case "VALUE":
+			days = strings.EqualFold(val, "DATE")
+		}
+	}
+	for _, value := range strings.Split(values, ",") {
+		t, err := parseDateValue(value, loc)
+		if err != nil {
+			return fmt.Errorf("invalid EXDATE in %q: %w", line, err)
+		}
+		t = rezone(t, s.Location)
+		if days || len(strings.TrimSpace(value)) == 8 {
+			s.ExDays = append(s.ExDays, t)
+		} else {
+			s.ExDates = append(s.ExDates, t)
+		}
+	}
+	return nil
+}
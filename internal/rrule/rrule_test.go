@@ -0,0 +1,232 @@
+package rrule
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// at parses "YYYY-MM-DD HH:MM:SS" in loc
+func at(s string, loc *time.Location) time.Time {
+	t, err := time.ParseInLocation(time.DateTime, s, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func formatAll(times []time.Time) []string {
+	var all []string
+	for _, t := range times {
+		all = append(all, t.Format("2006-01-02 15:04 MST"))
+	}
+	return all
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	r, err := Parse("RRULE:freq=monthly;interval=2;byday=-1FR,+2mo;bysetpos=1;wkst=SU;count=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Freq != Monthly || r.Interval != 2 || r.Count != 5 || r.WeekStart != time.Sunday {
+		t.Errorf("Parse = %+v", r)
+	}
+	wantDays := []WeekdayNum{{-1, time.Friday}, {2, time.Monday}}
+	if !slices.Equal(r.ByDay, wantDays) || !slices.Equal(r.BySetPos, []int{1}) {
+		t.Errorf("ByDay = %v, BySetPos = %v", r.ByDay, r.BySetPos)
+	}
+
+	r, err = Parse("FREQ=DAILY;UNTIL=20261231T235959Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Until.Equal(time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)) || r.UntilFloating {
+		t.Errorf("UTC UNTIL = %s, floating %v", r.Until, r.UntilFloating)
+	}
+	r, err = Parse("FREQ=DAILY;UNTIL=20261231")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Until.Day() != 31 || r.Until.Hour() != 23 || !r.UntilFloating {
+		t.Errorf("date UNTIL = %s, floating %v", r.Until, r.UntilFloating)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"":                                  "FREQ is required",
+		"INTERVAL=2":                        "FREQ is required",
+		"FREQ=FORTNIGHTLY":                  "unknown FREQ",
+		"FREQ=DAILY;FREQ=WEEKLY":            "given twice",
+		"FREQ=DAILY;INTERVAL=0":             "positive integer",
+		"FREQ=DAILY;COUNT=3;UNTIL=20261231": "COUNT and UNTIL",
+		"FREQ=WEEKLY;BYDAY=2MO":             "ordinals",
+		"FREQ=MONTHLY;BYDAY=6MO":            "out of range",
+		"FREQ=MONTHLY;BYDAY=XX":             "invalid BYDAY",
+		"FREQ=MONTHLY;BYMONTHDAY=0":         "BYMONTHDAY values",
+		"FREQ=WEEKLY;BYMONTHDAY=1":          "cannot be used with FREQ=WEEKLY",
+		"FREQ=MONTHLY;BYYEARDAY=1":          "BYYEARDAY cannot",
+		"FREQ=YEARLY;BYMONTH=13":            "BYMONTH values",
+		"FREQ=DAILY;BYHOUR=24":              "BYHOUR values",
+		"FREQ=DAILY;BYSETPOS=1":             "BYSETPOS needs",
+		"FREQ=YEARLY;BYWEEKNO=20":           "not supported",
+		"FREQ=DAILY;COLOR=RED":              "unknown part",
+		"FREQ=DAILY;UNTIL=tomorrow":         "invalid UNTIL",
+		"FREQ":                              "expected NAME=VALUE",
+	}
+	for rule, want := range tests {
+		if _, err := Parse(rule); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) error = %v, want it to mention %q", rule, err, want)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+	ny := mustLocation("America/New_York")
+	tests := []struct {
+		name  string
+		rule  string
+		start string
+		opts  Options
+		want  []string
+	}{
+		// the first group comes from the examples in RFC 5545 section 3.8.5.3
+		{"daily", "FREQ=DAILY;COUNT=3", "1997-09-02 09:00:00", Options{},
+			[]string{"1997-09-02 09:00 EDT", "1997-09-03 09:00 EDT", "1997-09-04 09:00 EDT"}},
+		{"first friday", "FREQ=MONTHLY;COUNT=5;BYDAY=1FR", "1997-09-05 09:00:00", Options{},
+			[]string{"1997-09-05 09:00 EDT", "1997-10-03 09:00 EDT", "1997-11-07 09:00 EST", "1997-12-05 09:00 EST", "1998-01-02 09:00 EST"}},
+		{"last workday", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "1997-09-29 09:00:00", Options{Limit: 4},
+			[]string{"1997-09-30 09:00 EDT", "1997-10-31 09:00 EST", "1997-11-28 09:00 EST", "1997-12-31 09:00 EST"}},
+		{"third to last day", "FREQ=MONTHLY;BYMONTHDAY=-3", "1997-09-28 09:00:00", Options{Limit: 4},
+			[]string{"1997-09-28 09:00 EDT", "1997-10-29 09:00 EST", "1997-11-28 09:00 EST", "1997-12-29 09:00 EST"}},
+		{"election day", "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8", "1996-11-05 09:00:00", Options{Limit: 3},
+			[]string{"1996-11-05 09:00 EST", "2000-11-07 09:00 EST", "2004-11-02 09:00 EST"}},
+		{"biweekly with WKST", "FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8", "1997-09-02 09:00:00", Options{},
+			[]string{"1997-09-02 09:00 EDT", "1997-09-04 09:00 EDT", "1997-09-16 09:00 EDT", "1997-09-18 09:00 EDT",
+				"1997-09-30 09:00 EDT", "1997-10-02 09:00 EDT", "1997-10-14 09:00 EDT", "1997-10-16 09:00 EDT"}},
+		{"friday the 13th", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", "1997-09-02 09:00:00", Options{Limit: 3},
+			[]string{"1998-02-13 09:00 EST", "1998-03-13 09:00 EST", "1998-11-13 09:00 EST"}},
+		{"20th monday of the year", "FREQ=YEARLY;BYDAY=20MO", "1997-05-19 09:00:00", Options{Limit: 3},
+			[]string{"1997-05-19 09:00 EDT", "1998-05-18 09:00 EDT", "1999-05-17 09:00 EDT"}},
+		{"every 20 minutes in office hours", "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16", "1997-09-02 16:00:00", Options{Limit: 4},
+			[]string{"1997-09-02 16:00 EDT", "1997-09-02 16:20 EDT", "1997-09-02 16:40 EDT", "1997-09-03 09:00 EDT"}},
+		{"floating until", "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T170000", "1997-09-02 09:00:00", Options{},
+			[]string{"1997-09-02 09:00 EDT", "1997-09-02 12:00 EDT", "1997-09-02 15:00 EDT"}},
+
+		{"last friday", "FREQ=MONTHLY;BYDAY=-1FR", "2026-10-18 08:00:00", Options{Limit: 4},
+			[]string{"2026-10-30 08:00 EDT", "2026-11-27 08:00 EST", "2026-12-25 08:00 EST", "2027-01-29 08:00 EST"}},
+		{"skips months without the 31st", "FREQ=MONTHLY;COUNT=4", "2026-01-31 12:00:00", Options{},
+			[]string{"2026-01-31 12:00 EST", "2026-03-31 12:00 EDT", "2026-05-31 12:00 EDT", "2026-07-31 12:00 EDT"}},
+		{"leap day", "FREQ=YEARLY;COUNT=3", "2024-02-29 12:00:00", Options{},
+			[]string{"2024-02-29 12:00 EST", "2028-02-29 12:00 EST", "2032-02-29 12:00 EST"}},
+		{"byhour expands", "FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30;COUNT=3", "2026-10-19 12:00:00", Options{},
+			[]string{"2026-10-19 17:30 EDT", "2026-10-20 09:30 EDT", "2026-10-20 17:30 EDT"}},
+		{"until bound", "FREQ=WEEKLY;BYDAY=MO,FR", "2026-10-19 09:00:00", Options{Until: at("2026-10-30 09:00:00", ny)},
+			[]string{"2026-10-19 09:00 EDT", "2026-10-23 09:00 EDT", "2026-10-26 09:00 EDT", "2026-10-30 09:00 EDT"}},
+		{"exdate counts toward COUNT", "FREQ=DAILY;COUNT=3", "2026-10-19 09:00:00", Options{ExDates: []time.Time{at("2026-10-20 09:00:00", ny)}},
+			[]string{"2026-10-19 09:00 EDT", "2026-10-21 09:00 EDT"}},
+		{"exday", "FREQ=WEEKLY;BYDAY=TH", "2026-11-19 09:00:00", Options{Limit: 2, ExDays: []time.Time{at("2026-11-26 00:00:00", ny)}},
+			[]string{"2026-11-19 09:00 EST", "2026-12-03 09:00 EST"}},
+
+		{"spring-forward gap moves forward", "FREQ=DAILY;COUNT=3", "2026-03-07 02:30:00", Options{},
+			[]string{"2026-03-07 02:30 EST", "2026-03-08 03:30 EDT", "2026-03-09 02:30 EDT"}},
+		{"fall-back overlap takes the first", "FREQ=DAILY;COUNT=2", "2026-10-31 01:30:00", Options{},
+			[]string{"2026-10-31 01:30 EDT", "2026-11-01 01:30 EDT"}},
+		{"hourly visits the repeated hour", "FREQ=HOURLY;COUNT=4", "2026-11-01 00:00:00", Options{},
+			[]string{"2026-11-01 00:00 EDT", "2026-11-01 01:00 EDT", "2026-11-01 01:00 EST", "2026-11-01 02:00 EST"}},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.rule)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		got, err := r.Expand(at(tt.start, ny), tt.opts)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(formatAll(got), tt.want) {
+			t.Errorf("%s:\n got %v\nwant %v", tt.name, formatAll(got), tt.want)
+		}
+	}
+}
+
+func TestExpandOverlapEastOfUTC(t *testing.T) {
+	t.Parallel()
+	berlin := mustLocation("Europe/Berlin")
+	r, _ := Parse("FREQ=DAILY;COUNT=2")
+	got, err := r.Expand(at("2026-10-24 02:30:00", berlin), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2026-10-24 02:30 CEST", "2026-10-25 02:30 CEST"}
+	if !slices.Equal(formatAll(got), want) {
+		t.Errorf("got %v, want %v", formatAll(got), want)
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	r, _ := Parse("FREQ=DAILY")
+	if _, err := r.Expand(start, Options{}); err != ErrUnbounded {
+		t.Errorf("unbounded Expand error = %v, want ErrUnbounded", err)
+	}
+	for _, impossible := range []string{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "FREQ=DAILY;BYMONTH=2;BYMONTHDAY=30"} {
+		r, _ = Parse(impossible)
+		if _, err := r.Expand(start, Options{Limit: 1}); err == nil {
+			t.Errorf("%s: expected an error", impossible)
+		}
+	}
+	r, _ = Parse("FREQ=YEARLY;INTERVAL=1000")
+	got, err := r.Expand(start, Options{Limit: 100})
+	if err != nil || len(got) != 8 {
+		t.Errorf("rule running past year 9999: %d occurrences, %v", len(got), err)
+	}
+}
+
+func TestParseSet(t *testing.T) {
+	t.Parallel()
+	text := "DTSTART;TZID=America/New_York:20260105T090000\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4\n" +
+		"EXDATE;TZID=America/New_York:20260119T090000\n" +
+		"EXDATE;VALUE=DATE:20260112\n"
+	set, err := ParseSet(text, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Location.String() != "America/New_York" || set.Start.Format(time.DateTime) != "2026-01-05 09:00:00" {
+		t.Fatalf("Location %s, Start %s", set.Location, set.Start)
+	}
+	got, err := set.Rule.Expand(set.Start, Options{ExDates: set.ExDates, ExDays: set.ExDays})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2026-01-05 09:00 EST", "2026-01-26 09:00 EST"}
+	if !slices.Equal(formatAll(got), want) {
+		t.Errorf("got %v, want %v", formatAll(got), want)
+	}
+
+	set, err = ParseSet("FREQ=DAILY;COUNT=2", time.UTC)
+	if err != nil || !set.Start.IsZero() || set.Location != time.UTC {
+		t.Errorf("bare rule: %+v, %v", set, err)
+	}
+	for _, bad := range []string{"DTSTART:20260105T090000", "RRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY", "SUMMARY:lunch\nRRULE:FREQ=DAILY", "DTSTART;TZID=Nowhere/Place:20260105T090000\nRRULE:FREQ=DAILY"} {
+		if _, err := ParseSet(bad, time.UTC); err == nil {
+			t.Errorf("ParseSet(%q): expected an error", bad)
+		}
+	}
+}
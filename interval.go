@@ -0,0 +1,258 @@
+// interval.go models time windows such as maintenance windows or on-call
+// shifts, and their set algebra: containment, overlap, intersection,
+// union, subtraction, merging, and splitting
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/humandur"
+)
+
+// Interval is the half-open span of time [Start, End): it holds Start but
+// not End, so back-to-back intervals such as consecutive shifts touch
+// without overlapping. An interval whose Start equals its End is empty.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewInterval returns the interval from start to end, erroring when end is
+// before start or the span exceeds time.Duration's range of about 292 years
+func NewInterval(start, end time.Time) (Interval, error) {
+	if end.Before(start) {
+		return Interval{}, fmt.Errorf("interval end %v is before its start %v", end, start)
+	}
+	if !start.Add(end.Sub(start)).Equal(end) {
+		return Interval{}, fmt.Errorf("interval from %v to %v exceeds the representable range of about 292 years", start, end)
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// ParseInterval parses "start,end", where start is any date/time the other
+// commands accept and end is either a date/time or a duration to add to
+// start in long, brief, or ISO 8601 form, such as
+// "2026-10-18 09:00,2026-10-18 17:00" or "2026-10-18 09:00,8h"; as with dur,
+// days and longer units move the calendar and keep the wall clock
+func ParseInterval(source string) (Interval, error) {
+	return defaultParser.parseInterval(source)
+}
+
+// parseInterval is ParseInterval with the Parser's settings
+func (p *Parser) parseInterval(source string) (Interval, error) {
+	startText, endText, found := strings.Cut(source, ",")
+	startText, endText = strings.TrimSpace(startText), strings.TrimSpace(endText)
+	if !found || startText == "" || endText == "" || strings.Contains(endText, ",") {
+		return Interval{}, fmt.Errorf("invalid interval %q: expected start,end such as 2026-10-18 09:00,2026-10-18 17:00 or 2026-10-18 09:00,8h", source)
+	}
+	start, err := p.parse(startText)
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval %q: %w", source, err)
+	}
+	end, err := p.parse(endText)
+	if err != nil {
+		var durErr error
+		end, durErr = addIntervalDuration(start, endText)
+		if durErr != nil {
+			return Interval{}, fmt.Errorf("invalid interval %q: %w", source, durErr)
+		}
+	}
+	iv, err := NewInterval(start, end)
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval %q: %w", source, err)
+	}
+	return iv, nil
+}
+
+// addIntervalDuration adds an interval's duration end to its start with
+// dur's calendar-aware period arithmetic; a negative ISO 8601 duration
+// subtracts, leaving NewInterval to reject the end before the start
+func addIntervalDuration(start time.Time, period string) (time.Time, error) {
+	op := opAdd
+	if isISODuration(period) {
+		if rest, negative := strings.CutPrefix(period, "-"); negative {
+			period, op = rest, opSub
+		}
+	}
+	periodMatches, err := parsePeriod(period)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return NewDur().applyPeriod(start, periodMatches, op)
+}
+
+// Duration returns the length of the interval
+func (iv Interval) Duration() time.Duration {
+	return iv.End.Sub(iv.Start)
+}
+
+// IsEmpty reports whether the interval holds no time at all
+func (iv Interval) IsEmpty() bool {
+	return !iv.End.After(iv.Start)
+}
+
+// Contains reports whether t is within the interval: at or after Start and
+// before End
+func (iv Interval) Contains(t time.Time) bool {
+	return !t.Before(iv.Start) && t.Before(iv.End)
+}
+
+// Overlaps reports whether the two intervals share any time; intervals
+// that only touch, one ending as the other starts, do not overlap
+func (iv Interval) Overlaps(other Interval) bool {
+	return iv.Start.Before(other.End) && other.Start.Before(iv.End)
+}
+
+// Intersect returns the time the two intervals share, and false when they
+// do not overlap
+func (iv Interval) Intersect(other Interval) (Interval, bool) {
+	if !iv.Overlaps(other) {
+		return Interval{}, false
+	}
+	return Interval{Start: latest(iv.Start, other.Start), End: earliest(iv.End, other.End)}, true
+}
+
+// Union returns the time held by either interval: one interval when they
+// overlap or touch, otherwise both, earliest first
+func (iv Interval) Union(other Interval) []Interval {
+	return MergeIntervals(iv, other)
+}
+
+// Subtract returns the parts of the interval not covered by other: none,
+// one, or, when other lies strictly inside, the two pieces on either side
+func (iv Interval) Subtract(other Interval) []Interval {
+	return SubtractIntervals(iv, other)
+}
+
+// SplitN divides the interval into n parts of equal length; any remainder
+// nanoseconds go to the last part, which always ends at End
+func (iv Interval) SplitN(n int) ([]Interval, error) {
+	if n < 1 || n > maxUntilIterations {
+		return nil, fmt.Errorf("number of parts must be between 1 and %d: %d", maxUntilIterations, n)
+	}
+	step := iv.Duration() / time.Duration(n)
+	parts := make([]Interval, 0, n)
+	for i := 0; i < n; i++ {
+		part := Interval{Start: iv.Start.Add(time.Duration(i) * step), End: iv.Start.Add(time.Duration(i+1) * step)}
+		if i == n-1 {
+			part.End = iv.End
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
+// SplitEvery divides the interval into consecutive parts of length step;
+// the last part ends at End and is shorter when step does not divide the
+// interval evenly
+func (iv Interval) SplitEvery(step time.Duration) ([]Interval, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be positive: %v", step)
+	}
+	if count := iv.Duration() / step; count >= maxUntilIterations {
+		return nil, fmt.Errorf("splitting %v into steps of %v exceeds the maximum of %d parts", iv.Duration(), step, maxUntilIterations)
+	}
+	var parts []Interval
+	for start := iv.Start; start.Before(iv.End); start = start.Add(step) {
+		parts = append(parts, Interval{Start: start, End: earliest(start.Add(step), iv.End)})
+	}
+	return parts, nil
+}
+
+// Format renders the interval as "start - end  length": the bounds with
+// strftime formatting, or time.Time's default when outputFormat is empty,
+// and the length in long form, such as "1 hour 30 minutes", or in brief
+// form, such as "1h30m"; options such as FormatWithLocale apply to the bounds
+func (iv Interval) Format(outputFormat string, brief bool, options ...OptionsFormat) (string, error) {
+	bounds, err := renderTimes([]time.Time{iv.Start, iv.End}, outputFormat, options...)
+	if err != nil {
+		return "", err
+	}
+	length := humandur.Format(iv.Duration())
+	if brief {
+		length = shrinkPeriod(length)
+	}
+	return fmt.Sprintf("%s - %s  %s", bounds[0], bounds[1], length), nil
+}
+
+func (iv Interval) String() string {
+	return fmt.Sprintf("[%v, %v)", iv.Start, iv.End)
+}
+
+// MergeIntervals returns the union of the intervals as the fewest
+// non-overlapping intervals, earliest first: intervals that overlap or
+// touch are joined, and empty intervals are dropped
+func MergeIntervals(intervals ...Interval) []Interval {
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(a, b Interval) int { return a.Start.Compare(b.Start) })
+	var merged []Interval
+	for _, iv := range sorted {
+		if iv.IsEmpty() {
+			continue
+		}
+		if last := len(merged) - 1; last >= 0 && !iv.Start.After(merged[last].End) {
+			merged[last].End = latest(merged[last].End, iv.End)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// IntersectIntervals returns the time shared by every interval, and false
+// when there is none
+func IntersectIntervals(intervals ...Interval) (Interval, bool) {
+	if len(intervals) == 0 {
+		return Interval{}, false
+	}
+	common := intervals[0]
+	for _, iv := range intervals[1:] {
+		var ok bool
+		if common, ok = common.Intersect(iv); !ok {
+			return Interval{}, false
+		}
+	}
+	return common, !common.IsEmpty()
+}
+
+// SubtractIntervals returns the gaps left in base once every interval in
+// remove is taken out, earliest first; subtracting busy times from a
+// working day leaves its free time
+func SubtractIntervals(base Interval, remove ...Interval) []Interval {
+	var gaps []Interval
+	cursor := base.Start
+	for _, iv := range MergeIntervals(remove...) {
+		if !iv.End.After(cursor) {
+			continue
+		}
+		if !iv.Start.Before(base.End) {
+			break
+		}
+		if iv.Start.After(cursor) {
+			gaps = append(gaps, Interval{Start: cursor, End: iv.Start})
+		}
+		cursor = iv.End
+	}
+	if cursor.Before(base.End) {
+		gaps = append(gaps, Interval{Start: cursor, End: base.End})
+	}
+	return gaps
+}
+
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
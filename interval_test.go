@@ -0,0 +1,202 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clockAt returns 2026-10-19 at the given hour and minute, in UTC
+func clockAt(hour, minute int) time.Time {
+	return time.Date(2026, 10, 19, hour, minute, 0, 0, time.UTC)
+}
+
+func span(startHour, startMinute, endHour, endMinute int) Interval {
+	return Interval{Start: clockAt(startHour, startMinute), End: clockAt(endHour, endMinute)}
+}
+
+func TestIntervalPredicates(t *testing.T) {
+	t.Parallel()
+	day := span(9, 0, 17, 0)
+	if !day.Contains(clockAt(9, 0)) || day.Contains(clockAt(17, 0)) || day.Contains(clockAt(8, 59)) {
+		t.Error("Contains must hold the start but not the end")
+	}
+	if !day.Overlaps(span(16, 0, 18, 0)) || day.Overlaps(span(17, 0, 18, 0)) || day.Overlaps(span(7, 0, 9, 0)) {
+		t.Error("Overlaps must be false for intervals that only touch")
+	}
+	if got := day.Duration(); got != 8*time.Hour {
+		t.Errorf("Duration = %v, want 8h", got)
+	}
+	if !span(9, 0, 9, 0).IsEmpty() || day.IsEmpty() {
+		t.Error("IsEmpty must be true only when Start equals End")
+	}
+}
+
+func TestIntervalSetAlgebra(t *testing.T) {
+	t.Parallel()
+	day := span(9, 0, 17, 0)
+	if got, ok := day.Intersect(span(12, 0, 20, 0)); !ok || got != span(12, 0, 17, 0) {
+		t.Errorf("Intersect = %v, %v; want 12:00-17:00", got, ok)
+	}
+	if _, ok := day.Intersect(span(17, 0, 18, 0)); ok {
+		t.Error("Intersect of touching intervals must be empty")
+	}
+	if got := day.Union(span(17, 0, 18, 0)); !slices.Equal(got, []Interval{span(9, 0, 18, 0)}) {
+		t.Errorf("Union of touching intervals = %v, want 09:00-18:00", got)
+	}
+	if got := day.Union(span(6, 0, 7, 0)); !slices.Equal(got, []Interval{span(6, 0, 7, 0), day}) {
+		t.Errorf("Union of disjoint intervals = %v, want both, earliest first", got)
+	}
+	if got := day.Subtract(span(12, 0, 13, 0)); !slices.Equal(got, []Interval{span(9, 0, 12, 0), span(13, 0, 17, 0)}) {
+		t.Errorf("Subtract of an inner interval = %v, want two pieces", got)
+	}
+	if got := day.Subtract(span(8, 0, 18, 0)); len(got) != 0 {
+		t.Errorf("Subtract of a covering interval = %v, want nothing", got)
+	}
+	merged := MergeIntervals(span(14, 0, 15, 0), span(9, 0, 11, 0), span(10, 30, 12, 0), span(12, 0, 12, 30), span(13, 0, 13, 0))
+	if want := []Interval{span(9, 0, 12, 30), span(14, 0, 15, 0)}; !slices.Equal(merged, want) {
+		t.Errorf("MergeIntervals = %v, want %v", merged, want)
+	}
+	if got, ok := IntersectIntervals(day, span(8, 0, 12, 0), span(11, 0, 20, 0)); !ok || got != span(11, 0, 12, 0) {
+		t.Errorf("IntersectIntervals = %v, %v; want 11:00-12:00", got, ok)
+	}
+	gaps := SubtractIntervals(day, span(13, 0, 14, 30), span(10, 0, 11, 0), span(16, 30, 18, 0), span(7, 0, 8, 0))
+	if want := []Interval{span(9, 0, 10, 0), span(11, 0, 13, 0), span(14, 30, 16, 30)}; !slices.Equal(gaps, want) {
+		t.Errorf("SubtractIntervals = %v, want %v", gaps, want)
+	}
+}
+
+func TestIntervalSplit(t *testing.T) {
+	t.Parallel()
+	day := span(9, 0, 17, 0)
+	parts, err := day.SplitN(3)
+	if err != nil || len(parts) != 3 || parts[0] != span(9, 0, 11, 40) || parts[2].End != day.End {
+		t.Errorf("SplitN(3) = %v, %v", parts, err)
+	}
+	parts, err = day.SplitEvery(3 * time.Hour)
+	if want := []Interval{span(9, 0, 12, 0), span(12, 0, 15, 0), span(15, 0, 17, 0)}; err != nil || !slices.Equal(parts, want) {
+		t.Errorf("SplitEvery(3h) = %v, %v; want %v", parts, err, want)
+	}
+	if _, err := day.SplitN(0); err == nil {
+		t.Error("SplitN(0) expected an error")
+	}
+	if _, err := day.SplitEvery(0); err == nil {
+		t.Error("SplitEvery(0) expected an error")
+	}
+	if _, err := day.SplitEvery(time.Nanosecond); err == nil {
+		t.Error("SplitEvery(1ns) over 8 hours expected an error")
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	t.Parallel()
+	tests := map[string]Interval{
+		"2026-10-19T09:00:00Z,2026-10-19T17:00:00Z": span(9, 0, 17, 0),
+		"2026-10-19T09:00:00Z, 8h":                  span(9, 0, 17, 0),
+		"2026-10-19T09:00:00Z,PT1H30M":              span(9, 0, 10, 30),
+		"2026-10-19T09:00:00Z,90 minutes":           span(9, 0, 10, 30),
+	}
+	for source, want := range tests {
+		if got, err := ParseInterval(source); err != nil || !got.Start.Equal(want.Start) || !got.End.Equal(want.End) {
+			t.Errorf("ParseInterval(%q) = %v, %v; want %v", source, got, err, want)
+		}
+	}
+	invalid := map[string]string{
+		"2026-10-19":                        "expected start,end",
+		"2026-10-19,":                       "expected start,end",
+		"Jan 2, 2026,Jan 3, 2026":           "expected start,end",
+		"2026-10-19,2026-10-18":             "is before its start",
+		"tuesday-ish,2026-10-18":            "invalid interval",
+		"2026-10-19,not a date":             "Invalid period: not a date",
+		"2026-10-19,1X":                     "Invalid period: 1X",
+		"0001-01-01T00:00:00Z,9999-01-01":   "exceeds the representable range",
+		"2026-10-19T09:00:00Z,-PT1H":        "is before its start",
+		"2026-10-19T09:00:00Z,2026-13-01Z0": "invalid interval",
+	}
+	for source, want := range invalid {
+		if _, err := ParseInterval(source); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseInterval(%q) error = %v, want it to mention %q", source, err, want)
+		}
+	}
+}
+
+func TestParseIntervalCalendarDuration(t *testing.T) {
+	t.Parallel()
+	// D, W, M, Q, and Y move the local calendar and keep the wall clock, as
+	// dur does, with its default overflow end-of-month policy; in
+	// America/New_York, 1D from noon the day before DST starts is 23 hours
+	at := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.Local)
+	}
+	tests := map[string]time.Time{
+		"2026-03-07 12:00,1D":  at(2026, 3, 8, 12),
+		"2026-03-07 12:00,P1D": at(2026, 3, 8, 12),
+		"2026-03-02 09:00,1W":  at(2026, 3, 9, 9),
+		"2026-01-31 09:00,1M":  at(2026, 3, 3, 9),
+		"2026-01-31 09:00,1Q":  at(2026, 5, 1, 9),
+		"2026-01-31 09:00,1Y":  at(2027, 1, 31, 9),
+		"2026-03-07 12:00,24h": at(2026, 3, 7, 12).Add(24 * time.Hour),
+	}
+	for source, want := range tests {
+		if iv, err := ParseInterval(source); err != nil || !iv.End.Equal(want) {
+			t.Errorf("ParseInterval(%q) = %v, %v; want it to end at %v", source, iv, err, want)
+		}
+	}
+}
+
+func TestRangeExpand(t *testing.T) {
+	t.Parallel()
+	morning := "2026-10-19T09:00:00Z,2026-10-19T11:00:00Z"
+	late := "2026-10-19T10:30:00Z,2026-10-19T12:00:00Z"
+	tests := []struct {
+		name  string
+		r     *Range
+		lines []string
+	}{
+		{"merge", NewRange(RangeWithOperation("merge"), RangeWithItems(morning, late), RangeWithOutputFormat("%H:%M")),
+			[]string{"09:00 - 12:00  3 hours"}},
+		{"union is merge", NewRange(RangeWithOperation("Union"), RangeWithItems(morning, late), RangeWithOutputFormat("%H:%M"), RangeWithBrief(true)),
+			[]string{"09:00 - 12:00  3h"}},
+		{"intersect", NewRange(RangeWithOperation("intersect"), RangeWithItems(morning, late), RangeWithOutputFormat("%H:%M")),
+			[]string{"10:30 - 11:00  30 minutes"}},
+		{"intersect without overlap", NewRange(RangeWithOperation("intersect"), RangeWithItems(morning, "2026-10-19T11:00:00Z,1h")),
+			[]string{}},
+		{"subtract", NewRange(RangeWithOperation("subtract"), RangeWithItems("2026-10-19T09:00:00Z,8h", "2026-10-19T10:00:00Z,1h", "2026-10-19T13:00:00Z,90m"), RangeWithOutputFormat("%H:%M")),
+			[]string{"09:00 - 10:00  1 hour", "11:00 - 13:00  2 hours", "14:30 - 17:00  2 hours 30 minutes"}},
+		{"overlaps", NewRange(RangeWithOperation("overlaps"), RangeWithItems(morning, late)),
+			[]string{"true"}},
+		{"contains", NewRange(RangeWithOperation("contains"), RangeWithItems(morning, "2026-10-19T09:00:00Z", "2026-10-19T11:00:00Z")),
+			[]string{"true", "false"}},
+		{"split parts", NewRange(RangeWithOperation("split"), RangeWithItems(morning), RangeWithParts(4), RangeWithOutputFormat("%H:%M")),
+			[]string{"09:00 - 09:30  30 minutes", "09:30 - 10:00  30 minutes", "10:00 - 10:30  30 minutes", "10:30 - 11:00  30 minutes"}},
+		{"split step", NewRange(RangeWithOperation("split"), RangeWithItems(morning), RangeWithStep("PT45M"), RangeWithOutputFormat("%H:%M"), RangeWithBrief(true)),
+			[]string{"09:00 - 09:45  45m", "09:45 - 10:30  45m", "10:30 - 11:00  30m"}},
+	}
+	for _, tt := range tests {
+		got, err := tt.r.Expand()
+		if err != nil || !slices.Equal(got, tt.lines) {
+			t.Errorf("%s: Expand() = %q, %v; want %q", tt.name, got, err, tt.lines)
+		}
+	}
+}
+
+func TestRangeExpandInvalid(t *testing.T) {
+	t.Parallel()
+	morning := "2026-10-19T09:00:00Z,2026-10-19T11:00:00Z"
+	tests := map[*Range]string{
+		NewRange(RangeWithOperation("frob"), RangeWithItems(morning)):                                          "unknown range operation",
+		NewRange(RangeWithOperation("merge")):                                                                  "no intervals given",
+		NewRange(RangeWithOperation("overlaps"), RangeWithItems(morning)):                                      "exactly two intervals",
+		NewRange(RangeWithOperation("contains"), RangeWithItems(morning)):                                      "followed by one or more date/times",
+		NewRange(RangeWithOperation("split"), RangeWithItems(morning)):                                         "a number of parts or a step",
+		NewRange(RangeWithOperation("split"), RangeWithItems(morning, morning), RangeWithParts(2)):             "exactly one interval",
+		NewRange(RangeWithOperation("split"), RangeWithItems(morning), RangeWithParts(2), RangeWithStep("1h")): "not both",
+		NewRange(RangeWithOperation("split"), RangeWithItems(morning), RangeWithStep("1 month")):               "invalid step",
+	}
+	for r, want := range tests {
+		if _, err := r.Expand(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%v: Expand() error = %v, want it to mention %q", r, err, want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// isodate.go parses the ISO 8601 date shapes that time.Parse has no layout
+// for or that the month-day layouts would reject: week dates such as
+// "2026-W07-3" and ordinal dates such as "2026-045"
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/dtparse"
+)
+
+var (
+	// weekDateRegexp matches a week date in extended ("2026-W07-3") or basic
+	// ("2026W073") form, with an optional weekday (1 is Monday) and an
+	// optional time of day after a "T" or a space; weekDatePrefixRegexp
+	// claims the input even when the rest is malformed
+	weekDateRegexp       = regexp.MustCompile(`^(\d{4})(-?)[Ww](\d{2})(?:(-?)(\d))?(?:[T ](.+))?$`)
+	weekDatePrefixRegexp = regexp.MustCompile(`^\d{4}-?[Ww]\d`)
+
+	// ordinalDateRegexp matches an ordinal date, a year and a three-digit day
+	// of the year such as "2026-045", with an optional time of day; the
+	// basic form "2026045" is a 7-digit integer, parsed by
+	// parseIntegerDateTime
+	ordinalDateRegexp = regexp.MustCompile(`^(\d{4})-(\d{3})(?:[T ](.+))?$`)
+)
+
+// parseISOWeekOrOrdinalDate parses an ISO 8601 week or ordinal date in loc;
+// a week without a weekday means its Monday. claimed is false when source
+// has neither shape, so the caller can try other parsers; once claimed, any
+// invalid component is an error.
+func parseISOWeekOrOrdinalDate(source string, loc *time.Location) (t time.Time, claimed bool, err error) {
+	var date time.Time
+	var clock string
+	if m := ordinalDateRegexp.FindStringSubmatch(source); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+		if days := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay(); day < 1 || day > days {
+			return time.Time{}, true, fmt.Errorf("invalid ISO 8601 ordinal date %q: day must be 001 to %d in %d", source, days, year)
+		}
+		date, clock = time.Date(year, time.January, day, 0, 0, 0, 0, loc), m[3]
+	} else if weekDatePrefixRegexp.MatchString(source) {
+		m := weekDateRegexp.FindStringSubmatch(source)
+		// the weekday separator must match the year's: "2026-W07-3" or "2026W073"
+		if m == nil || (m[5] != "" && m[2] != m[4]) {
+			return time.Time{}, true, fmt.Errorf("invalid ISO 8601 week date %q: expected a form such as 2026-W07-3 or 2026W073", source)
+		}
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[3])
+		day := 1
+		if m[5] != "" {
+			day, _ = strconv.Atoi(m[5])
+			if day < 1 || day > 7 {
+				return time.Time{}, true, fmt.Errorf("invalid ISO 8601 week date %q: weekday must be 1 (Monday) to 7 (Sunday)", source)
+			}
+		}
+		start, _, err := ISOWeekRule.Bounds(year, week, loc)
+		if err != nil {
+			return time.Time{}, true, fmt.Errorf("invalid ISO 8601 week date %q: %w", source, err)
+		}
+		date, clock = start.AddDate(0, 0, day-1), m[6]
+	} else {
+		return time.Time{}, false, nil
+	}
+	if clock == "" {
+		return date, true, nil
+	}
+	// the time of day takes any shape accepted on its own, such as "08:30",
+	// "15:04:05.5", or "3:04pm"
+	c, kind, err := dtparse.Parse(clock, loc)
+	if err != nil || kind != dtparse.KindTimeOnly {
+		return time.Time{}, true, fmt.Errorf("invalid time of day %q in %q", clock, source)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), c.Hour(), c.Minute(), c.Second(), c.Nanosecond(), loc), true, nil
+}
+
+// isoDetection describes the ISO 8601 week or ordinal date in source; only
+// a bare date has equivalent formats, and a week date has no Go layout
+func isoDetection(source string) Detection {
+	if m := ordinalDateRegexp.FindStringSubmatch(source); m != nil {
+		d := Detection{Layer: LayerISOOrdinalDate, Kind: KindWallClock}
+		if m[3] == "" {
+			d.Layout = "2006-002"
+		}
+		return d
+	}
+	d := Detection{Layer: LayerISOWeekDate, Kind: KindWallClock}
+	if m := weekDateRegexp.FindStringSubmatch(source); m != nil && m[6] == "" {
+		d.Strftime = "%G" + m[2] + "W%V"
+		if m[5] != "" {
+			d.Strftime += m[4] + "%u"
+		}
+	}
+	return d
+}
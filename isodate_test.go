@@ -0,0 +1,80 @@
+package DateTimeMate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseISOWeekOrOrdinalDate(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"2026-W07-3":            "2026-02-11 00:00:00",
+		"2026W073":              "2026-02-11 00:00:00",
+		"2026-w07-7":            "2026-02-15 00:00:00",
+		"2026-W07":              "2026-02-09 00:00:00",
+		"2026W07":               "2026-02-09 00:00:00",
+		"2026-W53-1":            "2026-12-28 00:00:00",
+		"2026-W01-1":            "2025-12-29 00:00:00",
+		"2026-W07-3T10:30":      "2026-02-11 10:30:00",
+		"2026-W07-3 10:30:15.5": "2026-02-11 10:30:15.5",
+		"2026-045":              "2026-02-14 00:00:00",
+		"2024-366":              "2024-12-31 00:00:00",
+		"2026-365":              "2026-12-31 00:00:00",
+		"2026-045T08:00":        "2026-02-14 08:00:00",
+		"2026-045 3:04pm":       "2026-02-14 15:04:00",
+	}
+	for source, want := range tests {
+		got, claimed, err := parseISOWeekOrOrdinalDate(source, time.UTC)
+		if !claimed || err != nil {
+			t.Errorf("parseISOWeekOrOrdinalDate(%q) = %v, %v; want %s", source, claimed, err, want)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05.999999999"); s != want {
+			t.Errorf("parseISOWeekOrOrdinalDate(%q) = %s, want %s", source, s, want)
+		}
+	}
+}
+
+func TestParseISOWeekOrOrdinalDateInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"2026-W54-1":     "week 54 is out of range",
+		"2026-W00":       "week 0 is out of range",
+		"2026-W07-8":     "weekday must be 1 (Monday) to 7 (Sunday)",
+		"2026-W073":      "expected a form such as",
+		"2026W07-3":      "expected a form such as",
+		"2026-W7":        "expected a form such as",
+		"2025-366":       "day must be 001 to 365 in 2025",
+		"2026-000":       "day must be 001 to 365",
+		"2026-045 25:00": "invalid time of day",
+		"2026-W07-3Tfoo": "invalid time of day",
+	}
+	for source, want := range tests {
+		_, claimed, err := parseISOWeekOrOrdinalDate(source, time.UTC)
+		if !claimed || err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("parseISOWeekOrOrdinalDate(%q) = %v, %v; want a claimed error mentioning %q", source, claimed, err, want)
+		}
+	}
+}
+
+func TestParseISOWeekOrOrdinalDateUnclaimed(t *testing.T) {
+	t.Parallel()
+	for _, source := range []string{"2026-02-14", "2026-04", "2026-4-5", "2026045", "now", "Wed"} {
+		if _, claimed, err := parseISOWeekOrOrdinalDate(source, time.UTC); claimed {
+			t.Errorf("parseISOWeekOrOrdinalDate(%q) claimed it, err = %v", source, err)
+		}
+	}
+}
+
+func TestWeekAndOrdinalDatesAcrossCommands(t *testing.T) {
+	t.Parallel()
+	diff := NewDiff(DiffWithStart("2026-W07-1"), DiffWithEnd("2026-045"))
+	if got, _, err := diff.CalculateDiff(); err != nil || got != "5 days" {
+		t.Errorf("CalculateDiff(2026-W07-1, 2026-045) = %q, %v; want 5 days", got, err)
+	}
+	dur := NewDur(DurWithFrom("2026045"), DurWithDur("1 day"), DurWithOutputFormat("%Y-%m-%d"))
+	if got, err := dur.Add(); err != nil || len(got) != 1 || got[0] != "2026-02-15" {
+		t.Errorf("Dur.Add(2026045 + 1 day) = %v, %v; want [2026-02-15]", got, err)
+	}
+}
@@ -0,0 +1,192 @@
+// isoduration.go translates ISO 8601 durations such as "P1Y2M3DT4H5M6.5S"
+// into the long form that dur, conv, and durmath already understand, and
+// renders their long-form results back as ISO 8601 durations
+
+package DateTimeMate
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// isoDateDesignators and isoTimeDesignators list, in their required order,
+// the designators allowed before and after the "T" of an ISO 8601 duration;
+// weeks may be combined with the other date components, as ISO 8601-1:2019
+// permits
+var (
+	isoDateDesignators = []isoDesignator{{'Y', "years"}, {'M', "months"}, {'W', "weeks"}, {'D', "days"}}
+	isoTimeDesignators = []isoDesignator{{'H', "hours"}, {'M', "minutes"}, {'S', "seconds"}}
+)
+
+// isoDefaultUnits break down a duration rendered as ISO 8601 when no target
+// units are given; years and months are left out because an ISO 8601 year
+// or month is a calendar unit, not a fixed 365.25 days
+var isoDefaultUnits = []string{"days", "hours", "minutes", "seconds", "milliseconds", "microseconds", "nanoseconds"}
+
+// errISOYears is returned when years are a target unit of ISO 8601 output:
+// conv and durmath count a year as a fixed 365.25 days, but an ISO 8601
+// year is a calendar year, so 400 days rendered as "P1Y34D" would read back
+// as 399 days and 6 hours
+var errISOYears = errors.New("ISO 8601 output cannot use years, which are calendar years in ISO 8601 but 365.25 days here; use weeks or days")
+
+// checkISOTargetUnits rejects target units that an ISO 8601 duration
+// cannot carry as fixed lengths
+func checkISOTargetUnits(units []string) error {
+	for _, unit := range units {
+		if normalizeUnit(unit) == "year" {
+			return errISOYears
+		}
+	}
+	return nil
+}
+
+// errBriefAndISO is returned when both brief and ISO 8601 output are requested
+var errBriefAndISO = errors.New("brief and ISO 8601 output are mutually exclusive")
+
+type isoDesignator struct {
+	letter byte
+	unit   string
+}
+
+// isISODuration reports whether s is written as an ISO 8601 duration: a
+// "P", optionally preceded by a sign; neither the long nor the brief form
+// can start this way
+func isISODuration(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	return strings.HasPrefix(s, "P")
+}
+
+// expandISODuration converts an ISO 8601 duration into a long-form period,
+// such as "P1DT1.5H" => "1 days 1.5 hours", and reports whether it carried
+// a leading "-"; a comma may replace the decimal point, and only the
+// smallest component may have a fraction
+func expandISODuration(s string) (string, bool, error) {
+	original := s
+	negative := false
+	if rest, found := strings.CutPrefix(s, "-"); found {
+		s, negative = rest, true
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+	s, found := strings.CutPrefix(s, "P")
+	if !found {
+		return "", false, fmt.Errorf("invalid ISO 8601 duration %q: must start with P", original)
+	}
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+	if datePart == "" && !hasTime {
+		return "", false, fmt.Errorf("invalid ISO 8601 duration %q: no components after P", original)
+	}
+	if hasTime && timePart == "" {
+		return "", false, fmt.Errorf("invalid ISO 8601 duration %q: no components after T", original)
+	}
+
+	var parts []string
+	fractional := false
+	for _, section := range []struct {
+		text        string
+		designators []isoDesignator
+	}{{datePart, isoDateDesignators}, {timePart, isoTimeDesignators}} {
+		rest := section.text
+		next := 0
+		for rest != "" {
+			end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+			if end == -1 {
+				return "", false, fmt.Errorf("invalid ISO 8601 duration %q: %q has no designator", original, rest)
+			}
+			amount, letter := strings.Replace(rest[:end], ",", ".", 1), rest[end]
+			rest = rest[end+1:]
+			for next < len(section.designators) && section.designators[next].letter != letter {
+				next++
+			}
+			if next == len(section.designators) {
+				return "", false, fmt.Errorf("invalid ISO 8601 duration %q: unexpected or out-of-order designator %q", original, string(letter))
+			}
+			if fractional {
+				return "", false, fmt.Errorf("invalid ISO 8601 duration %q: only the smallest component may have a fraction", original)
+			}
+			if !isValidAmount(amount) {
+				return "", false, fmt.Errorf("invalid ISO 8601 duration %q: invalid amount %q", original, amount)
+			}
+			fractional = strings.Contains(amount, ".")
+			parts = append(parts, amount+" "+section.designators[next].unit)
+			next++
+		}
+	}
+	return strings.Join(parts, " "), negative, nil
+}
+
+// isoSubSecondExponents map the units folded into an ISO 8601 seconds
+// component to their power-of-ten scale
+var isoSubSecondExponents = map[string]int{"second": 0, "millisecond": 3, "microsecond": 6, "nanosecond": 9}
+
+// formatISODuration renders a long-form duration, such as "1 day 12 hours"
+// or "-1.50 hours", as an ISO 8601 duration: "P1DT12H" or "-PT1.50H";
+// milliseconds, microseconds, and nanoseconds are folded into a fractional
+// seconds component, and a duration with no components is "PT0S"
+func formatISODuration(long string) (string, error) {
+	rest, negative := strings.CutPrefix(strings.TrimSpace(long), "-")
+	fields := strings.Fields(rest)
+	if len(fields)%2 != 0 {
+		return "", fmt.Errorf("cannot render %q as an ISO 8601 duration", long)
+	}
+	var datePart, timePart strings.Builder
+	seconds := new(big.Rat)
+	hasSeconds, hasSubSeconds := false, false
+	places, minPlaces := 0, 0
+	for i := 0; i < len(fields); i += 2 {
+		amount, unit := fields[i], normalizeUnit(fields[i+1])
+		if !isValidAmount(amount) || strings.HasPrefix(amount, "-") {
+			return "", fmt.Errorf("cannot render %q as an ISO 8601 duration: invalid amount %q", long, amount)
+		}
+		_, frac, _ := strings.Cut(amount, ".")
+		switch unit {
+		case "year", "month", "week", "day":
+			datePart.WriteString(amount + strings.ToUpper(unit[:1]))
+		case "hour", "minute":
+			timePart.WriteString(amount + strings.ToUpper(unit[:1]))
+		case "second", "millisecond", "microsecond", "nanosecond":
+			exponent := isoSubSecondExponents[unit]
+			value, _ := new(big.Rat).SetString(amount)
+			scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil))
+			seconds.Add(seconds, value.Quo(value, scale))
+			places = max(places, len(frac)+exponent)
+			if unit == "second" {
+				minPlaces = len(frac)
+			} else {
+				hasSubSeconds = true
+			}
+			hasSeconds = true
+		default:
+			return "", fmt.Errorf("cannot render %q as an ISO 8601 duration: unsupported unit %q", long, fields[i+1])
+		}
+	}
+	if hasSeconds {
+		text := seconds.FloatString(places)
+		if hasSubSeconds {
+			// keep the precision the seconds component was rounded to, but
+			// drop the trailing zeros that only the sub-second scale added
+			whole, frac, _ := strings.Cut(text, ".")
+			frac = strings.TrimRight(frac, "0")
+			if len(frac) < minPlaces {
+				frac += strings.Repeat("0", minPlaces-len(frac))
+			}
+			text = whole
+			if frac != "" {
+				text += "." + frac
+			}
+		}
+		timePart.WriteString(text + "S")
+	}
+	iso := "P" + datePart.String()
+	if timePart.Len() > 0 {
+		iso += "T" + timePart.String()
+	} else if datePart.Len() == 0 {
+		iso = "PT0S"
+	}
+	if negative {
+		iso = "-" + iso
+	}
+	return iso, nil
+}
@@ -0,0 +1,223 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestExpandISODuration(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		iso      string
+		want     string
+		negative bool
+	}{
+		{"P1Y2M3DT4H5M6.5S", "1 years 2 months 3 days 4 hours 5 minutes 6.5 seconds", false},
+		{"P2W", "2 weeks", false},
+		{"P1W2D", "1 weeks 2 days", false},
+		{"PT36H", "36 hours", false},
+		{"PT0.5S", "0.5 seconds", false},
+		{"PT6,5S", "6.5 seconds", false},
+		{"P1.5D", "1.5 days", false},
+		{"PT0S", "0 seconds", false},
+		{"-P1D", "1 days", true},
+		{"+PT1M", "1 minutes", false},
+	}
+	for _, tt := range tests {
+		got, negative, err := expandISODuration(tt.iso)
+		if err != nil || got != tt.want || negative != tt.negative {
+			t.Errorf("expandISODuration(%q) = %q, %v, %v; want %q, %v", tt.iso, got, negative, err, tt.want, tt.negative)
+		}
+	}
+}
+
+func TestExpandISODurationInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"P":        "no components after P",
+		"PT":       "no components after T",
+		"P1DT":     "no components after T",
+		"P1H":      "out-of-order designator",
+		"PT1D":     "out-of-order designator",
+		"PT1M2H":   "out-of-order designator",
+		"P1D1D":    "out-of-order designator",
+		"P1.5DT2H": "only the smallest component",
+		"P1":       "has no designator",
+		"PD":       "invalid amount",
+		"P1..5D":   "invalid amount",
+		"P-1D":     "out-of-order designator",
+		"P1 D":     "out-of-order designator",
+	}
+	for iso, want := range tests {
+		if _, _, err := expandISODuration(iso); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expandISODuration(%q) error = %v, want it to mention %q", iso, err, want)
+		}
+	}
+}
+
+func TestDurISODuration(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options []OptionsDur
+		sub     bool
+		want    []string
+	}{
+		{"calendar components", []OptionsDur{DurWithFrom("2024-01-31"), DurWithDur("P1Y1M")}, false, []string{"2025-03-03 00:00:00"}},
+		{"clamped month ends", []OptionsDur{DurWithFrom("2024-01-31"), DurWithDur("P1M"), DurWithRepeat(2), DurWithEndOfMonth(EndOfMonthClamp)}, false,
+			[]string{"2024-02-29 00:00:00", "2024-03-31 00:00:00"}},
+		{"a day is a calendar day across DST", []OptionsDur{DurWithFrom("2026-03-07 12:00:00"), DurWithInputZone("America/New_York"), DurWithDur("P1D")}, false, []string{"2026-03-08 12:00:00"}},
+		{"hours are elapsed time across DST", []OptionsDur{DurWithFrom("2026-03-07 12:00:00"), DurWithInputZone("America/New_York"), DurWithDur("PT24H")}, false, []string{"2026-03-08 13:00:00"}},
+		{"weeks", []OptionsDur{DurWithFrom("2026-10-18"), DurWithDur("P2W")}, true, []string{"2026-10-04 00:00:00"}},
+		{"fractional smallest component", []OptionsDur{DurWithFrom("2026-10-18"), DurWithDur("P1DT1.5H")}, false, []string{"2026-10-19 01:30:00"}},
+		{"negative reverses add", []OptionsDur{DurWithFrom("2026-10-18"), DurWithDur("-P1D")}, false, []string{"2026-10-17 00:00:00"}},
+		{"negative reverses sub", []OptionsDur{DurWithFrom("2026-10-18"), DurWithDur("-P1D")}, true, []string{"2026-10-19 00:00:00"}},
+	}
+	for _, tt := range tests {
+		dur := NewDur(append(tt.options, DurWithOutputFormat("%Y-%m-%d %H:%M:%S"))...)
+		var got []string
+		var err error
+		if tt.sub {
+			got, err = dur.Sub()
+		} else {
+			got, err = dur.Add()
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := NewDur(DurWithFrom("2026-10-18"), DurWithDur("P1.5M")).Add(); err == nil || !strings.Contains(err.Error(), "whole numbers") {
+		t.Errorf("P1.5M: error = %v, want a whole numbers error", err)
+	}
+}
+
+func TestConvISODuration(t *testing.T) {
+	t.Parallel()
+	testConv(t, "P1DT2H30M", "minutes", false, "1590 minutes")
+	testConv(t, "P2W3DT4H5M6,5S", "hms.ms", false, "412 hours 5 minutes 6 seconds 500 milliseconds")
+	testConv(t, "-PT90M", "hm", true, "-1h30m")
+	testConv(t, "P1Y", "Dh", false, "365 days 6 hours")
+	if _, err := NewConv(ConvWithSource("P1M"), ConvWithTarget("days")).ConvertDuration(); err == nil || !strings.Contains(err.Error(), "no fixed length") {
+		t.Errorf("P1M: error = %v, want a no fixed length error", err)
+	}
+}
+
+func TestConvISORoundTrip(t *testing.T) {
+	t.Parallel()
+	// ISO 8601 output read back by conv is the duration it came from
+	for _, tt := range []struct{ source, target string }{
+		{"400D", "WD"}, {"400D", "D"}, {"36h", "Dh"}, {"90061.5s", "Dhms.ms"}, {"P1Y", "Dhm"}, {"4321s123456789ns", "hms.msusns"},
+	} {
+		want, err := NewConv(ConvWithSource(tt.source), ConvWithTarget("ns")).ConvertDuration()
+		if err != nil {
+			t.Fatal(err)
+		}
+		iso, err := NewConv(ConvWithSource(tt.source), ConvWithTarget(tt.target), ConvWithISO(true)).ConvertDuration()
+		if err != nil {
+			t.Errorf("conv %s %s --iso: %v", tt.source, tt.target, err)
+			continue
+		}
+		if got, err := NewConv(ConvWithSource(iso), ConvWithTarget("ns")).ConvertDuration(); err != nil || got != want {
+			t.Errorf("conv %s %s --iso = %s, which reads back as %q, %v; want %q", tt.source, tt.target, iso, got, err, want)
+		}
+	}
+	// years would not read back: 400 days is not P1Y34D
+	for _, target := range []string{"YD", "years days", "Y"} {
+		if got, err := NewConv(ConvWithSource("400D"), ConvWithTarget(target), ConvWithISO(true)).ConvertDuration(); err != errISOYears {
+			t.Errorf("conv 400D %s --iso = %q, %v; want errISOYears", target, got, err)
+		}
+	}
+	if got, err := NewDurMath(DurMathWithFirst("400D"), DurMathWithSecond("1D"), DurMathWithTarget("YD"), DurMathWithISO(true)).Add(); err != errISOYears {
+		t.Errorf("durmath --iso with a years target = %q, %v; want errISOYears", got, err)
+	}
+}
+
+func TestDurMathISODuration(t *testing.T) {
+	t.Parallel()
+	got, err := NewDurMath(DurMathWithFirst("P1DT2H"), DurMathWithSecond("90m"), DurMathWithBrief(true)).Add()
+	if want := "1D3h30m"; err != nil || got != want {
+		t.Errorf("Add = %q, %v, want %q", got, err, want)
+	}
+	got, err = NewDurMath(DurMathWithFirst("PT1H"), DurMathWithSecond("1 hour 30 minutes")).Sub()
+	if want := "-30 minutes"; err != nil || got != want {
+		t.Errorf("Sub = %q, %v, want %q", got, err, want)
+	}
+	if _, err := NewDurMath(DurMathWithFirst("-PT1H"), DurMathWithSecond("1h")).Add(); err != ErrNegativeDuration {
+		t.Errorf("negative operand: error = %v, want ErrNegativeDuration", err)
+	}
+}
+
+func TestFormatISODuration(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"1 day 12 hours":                   "P1DT12H",
+		"36 hours":                         "PT36H",
+		"-1.50 hours":                      "-PT1.50H",
+		"4 years 1 month 2 days":           "P4Y1M2D",
+		"2 weeks 3 days":                   "P2W3D",
+		"6 seconds 500 milliseconds":       "PT6.5S",
+		"2 milliseconds 3 nanoseconds":     "PT0.002000003S",
+		"1 minute 2.50 seconds":            "PT1M2.50S",
+		"1 second 500.0 milliseconds":      "PT1.5S",
+		"0 seconds":                        "PT0S",
+		"0.00 hours":                       "PT0.00H",
+		"1 hour 0 minutes 45 microseconds": "PT1H0M0.000045S",
+	}
+	for long, want := range tests {
+		if got, err := formatISODuration(long); err != nil || got != want {
+			t.Errorf("formatISODuration(%q) = %q, %v; want %q", long, got, err, want)
+		}
+	}
+	for _, long := range []string{"1 quarter", "1 hour 2", "-1 -2 hours"} {
+		if got, err := formatISODuration(long); err == nil {
+			t.Errorf("formatISODuration(%q) = %q, expected an error", long, got)
+		}
+	}
+}
+
+func TestISOOutput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		compute func() (string, error)
+		want    string
+	}{
+		{"conv target days and hours", NewConv(ConvWithSource("36h"), ConvWithTarget("Dh"), ConvWithISO(true)).ConvertDuration, "P1DT12H"},
+		{"conv target hours", NewConv(ConvWithSource("36h"), ConvWithTarget("h"), ConvWithISO(true)).ConvertDuration, "PT36H"},
+		{"conv decimals", NewConv(ConvWithSource("90m"), ConvWithTarget("h"), ConvWithDecimals(2), ConvWithISO(true)).ConvertDuration, "PT1.50H"},
+		{"conv sub-second units", NewConv(ConvWithSource("4321s123456789ns"), ConvWithTarget("hms.msusns"), ConvWithISO(true)).ConvertDuration, "PT1H12M1.123456789S"},
+		{"conv negative", NewConv(ConvWithSource("-PT90M"), ConvWithTarget("hm"), ConvWithISO(true)).ConvertDuration, "-PT1H30M"},
+		{"durmath defaults to days", NewDurMath(DurMathWithFirst("1 year"), DurMathWithSecond("1 day"), DurMathWithISO(true)).Add, "P366DT6H"},
+		{"durmath negative", NewDurMath(DurMathWithFirst("45m"), DurMathWithSecond("1h"), DurMathWithISO(true)).Sub, "-PT15M"},
+		{"durmath target", NewDurMath(DurMathWithFirst("1 day"), DurMathWithSecond("90m"), DurMathWithTarget("minutes"), DurMathWithISO(true)).Sub, "PT1350M"},
+		{"diff", func() (string, error) {
+			s, _, err := NewDiff(DiffWithStart("2024-06-07T08:00:00.5Z"), DiffWithEnd("2024-06-08T09:02:03Z"), DiffWithISO(true)).CalculateDiff()
+			return s, err
+		}, "P1DT1H2M2.5S"},
+		{"diff zero", func() (string, error) {
+			s, _, err := NewDiff(DiffWithStart("2024-06-07T08:00:00Z"), DiffWithEnd("2024-06-07T08:00:00Z"), DiffWithISO(true)).CalculateDiff()
+			return s, err
+		}, "PT0S"},
+		{"calendar diff", func() (string, error) {
+			s, _, err := NewDiff(DiffWithStart("2024-03-31T10:30:00Z"), DiffWithEnd("2020-02-29T00:00:00Z"), DiffWithISO(true)).CalculateCalendarDiff()
+			return s, err
+		}, "-P4Y1M2DT10H30M"},
+	}
+	for _, tt := range tests {
+		got, err := tt.compute()
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v; want %q", tt.name, got, err, tt.want)
+		}
+	}
+	if _, err := NewConv(ConvWithSource("1h"), ConvWithTarget("m"), ConvWithBrief(true), ConvWithISO(true)).ConvertDuration(); err != errBriefAndISO {
+		t.Errorf("brief and ISO conv: error = %v, want errBriefAndISO", err)
+	}
+	if _, _, err := NewDiff(DiffWithStart("12:00"), DiffWithEnd("13:00"), DiffWithBrief(true), DiffWithISO(true)).CalculateDiff(); err != errBriefAndISO {
+		t.Errorf("brief and ISO diff: error = %v, want errBriefAndISO", err)
+	}
+}
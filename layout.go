@@ -0,0 +1,168 @@
+// layout.go formats date/times with Go reference layouts such as
+// "2006-01-02T15:04:05.000Z07:00", or the time package's named layouts, as
+// an alternative to strftime specifiers
+
+package DateTimeMate
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// LayoutPresets maps the names of the time package's layouts to the layouts
+var LayoutPresets = map[string]string{
+	"Layout":      time.Layout,
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// LayoutNames returns the names of LayoutPresets, sorted
+func LayoutNames() []string {
+	return slices.Sorted(maps.Keys(LayoutPresets))
+}
+
+// ErrFormatAndLayout is returned when both a strftime format and a Go layout
+// are given for the same output
+var ErrFormatAndLayout = errors.New("a strftime format and a Go layout cannot be combined")
+
+// layoutProbes differ in every reference-time element, so a layout renders
+// them identically only when it holds no element at all
+var layoutProbes = [2]time.Time{
+	time.Date(2001, 2, 3, 4, 5, 6, 7, time.UTC),
+	time.Date(2012, 11, 24, 16, 27, 38, 123456789, time.FixedZone("XYZ", -9000)),
+}
+
+// ResolveLayout returns the Go layout named by layout, a name from
+// LayoutPresets in any case such as "rfc3339", or else layout itself; a
+// layout holding no reference-time element, which is most often a
+// misspelled name or a strftime format, is an error
+func ResolveLayout(layout string) (string, error) {
+	for name, preset := range LayoutPresets {
+		if strings.EqualFold(layout, name) {
+			return preset, nil
+		}
+	}
+	if layoutProbes[0].Format(layout) == layoutProbes[1].Format(layout) {
+		return "", fmt.Errorf("layout %q has no reference-time elements such as 2006, 01, 02, or 15:04:05; named layouts are: %s", layout, strings.Join(LayoutNames(), ", "))
+	}
+	return layout, nil
+}
+
+// FormatWithLayout renders with a Go reference layout such as
+// "2006-01-02T15:04:05.000Z07:00", or a name from LayoutPresets such as
+// "RFC3339", instead of a strftime format, which must then be empty. Go
+// layouts always use English month and weekday names.
+func FormatWithLayout(layout string) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.layout = layout
+	}
+}
+
+// newFormatter returns the function rendering times with either the strftime
+// outputFormat or the Go layout named by the options, but never both; ok is
+// false when neither is given
+func newFormatter(outputFormat string, options ...OptionsFormat) (format func(time.Time) string, ok bool, err error) {
+	var settings formatSettings
+	for _, opt := range options {
+		opt(&settings)
+	}
+	if settings.layout != "" {
+		if outputFormat != "" {
+			return nil, false, fmt.Errorf("%w: %q and %q", ErrFormatAndLayout, outputFormat, settings.layout)
+		}
+		layout, err := ResolveLayout(settings.layout)
+		if err != nil {
+			return nil, false, err
+		}
+		return func(t time.Time) string { return t.Format(layout) }, true, nil
+	}
+	if outputFormat == "" {
+		return nil, false, nil
+	}
+	f, err := newStrftime(outputFormat, options...)
+	if err != nil {
+		return nil, false, err
+	}
+	return f.FormatString, true, nil
+}
+
+// layoutElements maps Go layout elements to strftime specifiers, ordered so
+// that each element is tried before any element that is its prefix; an
+// empty specifier marks an element strftime cannot render
+var layoutElements = [][2]string{
+	{"January", "%B"}, {"Jan", "%b"}, {"Monday", "%A"}, {"Mon", "%a"}, {"MST", "%Z"},
+	{"2006", "%Y"}, {"002", "%j"}, {"__2", ""}, {"_2", "%e"},
+	{"01", "%m"}, {"02", "%d"}, {"03", "%I"}, {"04", "%M"}, {"05", "%S"}, {"06", "%y"},
+	{"15", "%H"}, {"1", "%-m"}, {"2", "%-d"}, {"3", "%-I"}, {"4", "%-M"}, {"5", "%-S"},
+	{"PM", "%p"}, {"pm", ""},
+	{"-07:00:00", ""}, {"-070000", ""}, {"-07:00", ""}, {"-0700", "%z"}, {"-07", ""},
+	{"Z07:00:00", ""}, {"Z070000", ""}, {"Z07:00", ""}, {"Z0700", ""}, {"Z07", ""},
+}
+
+// fractionSpecifiers maps the digit counts of a layout's ".000" fractional
+// seconds to strftime specifiers
+var fractionSpecifiers = map[int]string{3: "%L", 6: "%f", 9: "%N"}
+
+// LayoutToStrftime returns the strftime format rendering times as the Go
+// layout does, or an error naming the first layout element with no strftime
+// equivalent, such as "Z07:00" or the trailing-zero-trimming ".999"
+func LayoutToStrftime(layout string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		if c := layout[i]; (c == '.' || c == ',') && i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+			j := i + 1
+			for j < len(layout) && layout[j] == layout[i+1] {
+				j++
+			}
+			if j == len(layout) || layout[j] < '0' || layout[j] > '9' {
+				spec, ok := fractionSpecifiers[j-i-1]
+				if !ok || layout[i+1] == '9' {
+					return "", fmt.Errorf("layout element %q has no strftime equivalent", layout[i:j])
+				}
+				b.WriteString(string(c) + spec)
+				i = j
+				continue
+			}
+		}
+		matched := false
+		for _, element := range layoutElements {
+			if strings.HasPrefix(layout[i:], element[0]) {
+				if element[1] == "" {
+					return "", fmt.Errorf("layout element %q has no strftime equivalent", element[0])
+				}
+				b.WriteString(element[1])
+				i += len(element[0])
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			if layout[i] == '%' {
+				b.WriteByte('%')
+			}
+			b.WriteByte(layout[i])
+			i++
+		}
+	}
+	return b.String(), nil
+}
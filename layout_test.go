@@ -0,0 +1,99 @@
+package DateTimeMate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatTimeWithLayout(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2026, 7, 22, 15, 4, 5, 123456789, time.FixedZone("EDT", -4*3600))
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"2006-01-02T15:04:05.000Z07:00", "2026-07-22T15:04:05.123-04:00"},
+		{"Monday 2 January", "Wednesday 22 July"},
+		{"RFC3339", "2026-07-22T15:04:05-04:00"},
+		{"rfc3339nano", "2026-07-22T15:04:05.123456789-04:00"},
+		{"Kitchen", "3:04PM"},
+		{"StampMilli", "Jul 22 15:04:05.123"},
+		{"DateOnly", "2026-07-22"},
+	}
+	for _, tt := range tests {
+		// a locale does not apply to Go layouts
+		got, err := FormatTime(tm, "", FormatWithLayout(tt.layout), FormatWithLocale("fr"))
+		if err != nil || got != tt.want {
+			t.Errorf("FormatTime(layout %q) = %q, %v; want %q", tt.layout, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormatWithLayoutErrors(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2026, 7, 22, 15, 4, 5, 0, time.UTC)
+	if _, err := FormatTime(tm, "%F", FormatWithLayout("RFC3339")); !errors.Is(err, ErrFormatAndLayout) {
+		t.Errorf("FormatTime with a format and a layout: err = %v, want ErrFormatAndLayout", err)
+	}
+	for _, layout := range []string{"RFC3399", "%Y-%m-%d", "today"} {
+		if _, err := FormatTime(tm, "", FormatWithLayout(layout)); err == nil || !strings.Contains(err.Error(), "RFC3339Nano") {
+			t.Errorf("FormatTime(layout %q): err = %v, want a no-elements error listing the named layouts", layout, err)
+		}
+	}
+}
+
+func TestResolveLayout(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]string{
+		"RFC1123Z":   time.RFC1123Z,
+		"kitchen":    time.Kitchen,
+		"15:04":      "15:04",
+		"Jan 2 2006": "Jan 2 2006",
+	} {
+		if got, err := ResolveLayout(name); err != nil || got != want {
+			t.Errorf("ResolveLayout(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if len(LayoutNames()) != len(LayoutPresets) || LayoutNames()[0] != "ANSIC" {
+		t.Errorf("LayoutNames() = %v", LayoutNames())
+	}
+}
+
+func TestDurWithLayout(t *testing.T) {
+	t.Parallel()
+	dur := NewDur(DurWithFrom("2026-07-22 09:30"), DurWithDur("1M"), DurWithRepeat(2), DurWithLayout("Mon Jan 2 15:04"))
+	got, err := dur.Add()
+	if err != nil || strings.Join(got, "|") != "Sat Aug 22 09:30|Tue Sep 22 09:30" {
+		t.Errorf("Add() = %q, %v", got, err)
+	}
+	dur = NewDur(DurWithFrom("2026-07-22"), DurWithDur("1D"), DurWithOutputFormat("%F"), DurWithLayout("DateOnly"))
+	if _, err := dur.Add(); !errors.Is(err, ErrFormatAndLayout) {
+		t.Errorf("Add() with a format and a layout: err = %v, want ErrFormatAndLayout", err)
+	}
+}
+
+func TestLayoutToStrftime(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2026, 7, 2, 8, 4, 5, 123456789, time.FixedZone("EDT", -4*3600))
+	for _, layout := range []string{
+		time.DateTime, time.RFC1123Z, time.UnixDate, time.StampMilli, time.Kitchen,
+		"Monday, January 2 2006 3:4:5 PM", "06-1-2 15.000000", "2006-002 100%",
+	} {
+		format, err := LayoutToStrftime(layout)
+		if err != nil {
+			t.Errorf("LayoutToStrftime(%q): %v", layout, err)
+			continue
+		}
+		// the strftime format must render exactly what the layout does
+		if got, err := FormatTime(tm, format); err != nil || got != tm.Format(layout) {
+			t.Errorf("LayoutToStrftime(%q) = %q, renders %q, %v; want %q", layout, format, got, err, tm.Format(layout))
+		}
+	}
+	for _, layout := range []string{time.RFC3339, time.RFC3339Nano, "15:04pm", "2006-01-02 -07:00"} {
+		if format, err := LayoutToStrftime(layout); err == nil {
+			t.Errorf("LayoutToStrftime(%q) = %q, expected an error", layout, format)
+		}
+	}
+}
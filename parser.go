@@ -0,0 +1,295 @@
+// parser.go exposes date/time parsing as a configurable Parser: the order of
+// ambiguous slash dates, the pivot for two-digit years, the location of
+// zone-less input, whether integers may be unix timestamps, and a strict mode
+// that rejects input whose meaning is guessed or depends on the current time
+
+package DateTimeMate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/locale"
+)
+
+// date orders for ambiguous slash dates such as "01/02/2026"
+const (
+	DateOrderMDY = "MDY"
+	DateOrderDMY = "DMY"
+)
+
+// DefaultYearPivot is the pivot used when a Parser's YearPivot is zero, the
+// same as Go's own: "69" through "99" are 1969 to 1999, "00" through "68"
+// are 2000 to 2068
+const DefaultYearPivot = 69
+
+// ErrStrictParse is returned when a strict Parser rejects lenient input
+var ErrStrictParse = errors.New("rejected by strict parsing")
+
+// Parser parses date/time strings with the same layers as every command,
+// but with its settings held in the Parser instead of the environment, so
+// parsers with different settings can be used concurrently.
+//
+// DateOrder settles ambiguous slash dates, DateOrderMDY or DateOrderDMY;
+// empty means month first. Two-digit
+// years below YearPivot are in the 2000s and the rest in the 1900s; zero
+// means DefaultYearPivot. Location interprets zone-less input; nil means the
+// local time zone. AllowUnix treats 10 and 13 digit integers as unix
+// timestamps. Strict rejects relative words and dates, date math anchored at
+// "now", a bare time of day, and ambiguous slash dates with no date order.
+// Clock is the reference instant for relative input and the date of a bare
+// time of day; nil means Now, the system time. Locales names the
+// languages, such as "fr" or "de", whose dates and relative words are read
+// in addition to English, or "all" for every one Locales returns; nil means
+// English only.
+type Parser struct {
+	DateOrder string
+	YearPivot int
+	Location  *time.Location
+	AllowUnix bool
+	Strict    bool
+	Clock     Clock
+	Locales   []string
+
+	// warnOnStderr prints ambiguity warnings, as the package-level parsing
+	// functions always have
+	warnOnStderr bool
+
+	// fromEnv reads DateOrderEnvVar when DateOrder is empty and
+	// ParseLocalesEnvVar when Locales is nil, as the package-level parsing
+	// functions always have
+	fromEnv bool
+}
+
+type OptionsParser func(*Parser)
+
+// NewParser returns a lenient Parser that allows unix timestamps
+func NewParser(options ...OptionsParser) *Parser {
+	p := &Parser{AllowUnix: true}
+	for _, opt := range options {
+		opt(p)
+	}
+	return p
+}
+
+// ParserWithDateOrder sets the order of ambiguous slash dates, "MDY" or "DMY"
+func ParserWithDateOrder(order string) OptionsParser {
+	return func(opt *Parser) {
+		opt.DateOrder = order
+	}
+}
+
+// ParserWithYearPivot sets the pivot for two-digit years, from 1 to 100
+func ParserWithYearPivot(pivot int) OptionsParser {
+	return func(opt *Parser) {
+		opt.YearPivot = pivot
+	}
+}
+
+// ParserWithLocation sets the location of zone-less input
+func ParserWithLocation(loc *time.Location) OptionsParser {
+	return func(opt *Parser) {
+		opt.Location = loc
+	}
+}
+
+// ParserWithUnixTimestamps sets whether integers may be unix timestamps
+func ParserWithUnixTimestamps(allow bool) OptionsParser {
+	return func(opt *Parser) {
+		opt.AllowUnix = allow
+	}
+}
+
+// ParserWithStrict rejects input whose meaning is guessed or depends on the
+// current time
+func ParserWithStrict(strict bool) OptionsParser {
+	return func(opt *Parser) {
+		opt.Strict = strict
+	}
+}
+
+// ParserWithClock sets the reference instant for relative input and the
+// date of a bare time of day
+func ParserWithClock(c Clock) OptionsParser {
+	return func(opt *Parser) {
+		opt.Clock = c
+	}
+}
+
+// ParserWithLocales sets the languages read in addition to English, such as
+// "fr" and "de", or "all"
+func ParserWithLocales(names ...string) OptionsParser {
+	return func(opt *Parser) {
+		opt.Locales = names
+	}
+}
+
+func (p *Parser) String() string {
+	return fmt.Sprintf("DateOrder:%v YearPivot:%v Location:%v AllowUnix:%v Strict:%v Locales:%v", p.DateOrder, p.YearPivot, p.Location, p.AllowUnix, p.Strict, p.Locales)
+}
+
+// defaultParser is the Parser behind the package-level parsing functions:
+// lenient, with the date order from DateOrderEnvVar, the locales from
+// ParseLocalesEnvVar, and ambiguity warnings printed to stderr
+var defaultParser = &Parser{AllowUnix: true, warnOnStderr: true, fromEnv: true}
+
+// orDefault returns p, or defaultParser when p is nil, so types holding an
+// optional Parser parse as the package-level functions do without one
+func (p *Parser) orDefault() *Parser {
+	if p == nil {
+		return defaultParser
+	}
+	return p
+}
+
+// location returns the location of zone-less input
+func (p *Parser) location() *time.Location {
+	if p == nil || p.Location == nil {
+		return time.Local
+	}
+	return p.Location
+}
+
+// validate checks the settings that are not checked where they are used
+func (p *Parser) validate() error {
+	switch strings.ToUpper(p.DateOrder) {
+	case "", DateOrderMDY, DateOrderDMY:
+	default:
+		return fmt.Errorf("date order must be %s or %s, not %q", DateOrderMDY, DateOrderDMY, p.DateOrder)
+	}
+	if p.YearPivot < 0 || p.YearPivot > 100 {
+		return fmt.Errorf("year pivot must be from 1 to 100, or 0 for %d, not %d", DefaultYearPivot, p.YearPivot)
+	}
+	return nil
+}
+
+// Parse parses source as every command does, with zone-less input in the
+// Parser's location, and describes how it was read: the layer and layout
+// that matched, the kind of result, where its zone came from, and how an
+// ambiguous slash date was ordered. An ambiguity that the Parser resolved
+// by default is reported in the Detection's Warning.
+//
+// Example usage:
+//
+//	p := NewParser(ParserWithDateOrder(DateOrderDMY), ParserWithStrict(true))
+//	t, d, err := p.Parse("03/02/2026 14:30") // February 3, d.DateOrder explains why
+func (p *Parser) Parse(source string) (time.Time, Detection, error) {
+	source = strings.TrimSpace(source)
+	loc := p.location()
+	t, d, err := p.detectDateTimeOrUnixIn(source, loc)
+	if err != nil {
+		return time.Time{}, Detection{}, err
+	}
+	d.describe(source, t, loc)
+	return t, d, nil
+}
+
+// parse parses source with zone-less input in the Parser's location; a nil
+// Parser parses as the package-level functions do
+func (p *Parser) parse(source string) (time.Time, error) {
+	return p.parseIn(source, p.location())
+}
+
+// parseIn is parse with zone-less input interpreted in loc
+func (p *Parser) parseIn(source string, loc *time.Location) (time.Time, error) {
+	t, _, err := p.orDefault().detectDateTimeOrUnixIn(source, loc)
+	return t, err
+}
+
+// inZone returns p, or a copy of it reading zone-less input in zone, resolved
+// by ResolveZone, when zone is set
+func (p *Parser) inZone(zone string) (*Parser, error) {
+	if zone == "" {
+		return p, nil
+	}
+	loc, err := ResolveZone(zone)
+	if err != nil {
+		return nil, fmt.Errorf("input zone: %w", err)
+	}
+	zoned := *p.orDefault()
+	zoned.Location = loc
+	return &zoned, nil
+}
+
+// locales returns the locales read in addition to English: Locales, or for
+// defaultParser those named by ParseLocalesEnvVar
+func (p *Parser) locales() ([]*locale.Locale, error) {
+	if p.Locales == nil && p.fromEnv {
+		l, err := lookupLocales(strings.Split(os.Getenv(ParseLocalesEnvVar), ","))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ParseLocalesEnvVar, err)
+		}
+		return l, nil
+	}
+	l, err := lookupLocales(p.Locales)
+	if err != nil {
+		return nil, fmt.Errorf("parser locales: %w", err)
+	}
+	return l, nil
+}
+
+// lookupLocales looks up names, skipping empty ones; "all" is every locale
+func lookupLocales(names []string) ([]*locale.Locale, error) {
+	var locales []*locale.Locale
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if strings.EqualFold(name, "all") {
+			return lookupLocales(locale.Names())
+		}
+		if name == "" {
+			continue
+		}
+		l, err := locale.Lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, l)
+	}
+	return locales, nil
+}
+
+// warn records an ambiguity warning in d, and prints it for defaultParser
+func (p *Parser) warn(d *Detection, format string, args ...any) {
+	d.Warning = fmt.Sprintf(format, args...)
+	if p.warnOnStderr {
+		fmt.Fprintln(os.Stderr, "warning: "+d.Warning)
+	}
+}
+
+// dateOrder returns the order of ambiguous slash dates and where it came
+// from: the Parser, DateOrderEnvVar for defaultParser, or neither, the
+// month-first default
+func (p *Parser) dateOrder() (order, origin string, err error) {
+	if p.DateOrder != "" {
+		return strings.ToUpper(p.DateOrder), "the parser's date order", nil
+	}
+	if !p.fromEnv {
+		return DateOrderMDY, "", nil
+	}
+	order = strings.ToUpper(strings.TrimSpace(os.Getenv(DateOrderEnvVar)))
+	switch order {
+	case "":
+		return DateOrderMDY, "", nil
+	case DateOrderMDY, DateOrderDMY:
+		return order, fmt.Sprintf("%s=%s", DateOrderEnvVar, order), nil
+	}
+	return "", "", fmt.Errorf("%s must be MDY or DMY, not %q", DateOrderEnvVar, order)
+}
+
+// pivotYear moves the year of t, parsed with a two-digit year by layout,
+// to the century set by YearPivot; a February 29 stays valid, since only
+// "00" could move to a century year that is not a leap year, and it never
+// moves below the pivot
+func (p *Parser) pivotYear(t time.Time, layout string) time.Time {
+	if p.YearPivot == 0 || p.YearPivot == DefaultYearPivot || !strings.Contains(strings.ReplaceAll(layout, "2006", ""), "06") {
+		return t
+	}
+	year := 1900 + t.Year()%100
+	if t.Year()%100 < p.YearPivot {
+		year += 100
+	}
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
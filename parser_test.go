@@ -0,0 +1,185 @@
+package DateTimeMate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParserDateOrder(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	t.Setenv(DateOrderEnvVar, "MDY")
+	dmy := NewParser(ParserWithDateOrder(DateOrderDMY))
+	got, d, err := dmy.Parse("03/02/2026 14:30")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got.Month() != time.February || got.Day() != 3 {
+		t.Errorf("Parse = %v, want February 3", got)
+	}
+	if d.Layer != LayerSlashDate || d.DateOrder != "day/month/year: ambiguous, set by the parser's date order" || d.Warning != "" {
+		t.Errorf("Parse detection = %v", d)
+	}
+
+	// a Parser without a date order is month first, whatever the environment
+	t.Setenv(DateOrderEnvVar, "DMY")
+	got, d, err = NewParser().Parse("03/02/2026")
+	if err != nil || got.Month() != time.March || strings.Contains(d.DateOrder, DateOrderEnvVar) || d.Warning == "" {
+		t.Errorf("Parse = %v, %v, %v", got, d, err)
+	}
+	t.Setenv(DateOrderEnvVar, "YMD")
+	if _, _, err := NewParser().Parse("03/02/2026"); err != nil {
+		t.Errorf("Parse with an invalid %s: %v", DateOrderEnvVar, err)
+	}
+
+	if _, _, err := NewParser(ParserWithDateOrder("YMD")).Parse("2026-03-02"); err == nil {
+		t.Error("Parse with date order YMD: want error")
+	}
+}
+
+func TestParserWarning(t *testing.T) {
+	t.Setenv(DateOrderEnvVar, "")
+	_, d, err := NewParser().Parse("03/02/2026")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !strings.Contains(d.Warning, "ambiguous") {
+		t.Errorf("Parse warning = %q, want the ambiguity", d.Warning)
+	}
+	_, _, err = NewParser(ParserWithStrict(true)).Parse("03/02/2026")
+	if !errors.Is(err, ErrStrictParse) {
+		t.Errorf("strict Parse of an ambiguous date: err = %v, want ErrStrictParse", err)
+	}
+}
+
+func TestParserYearPivot(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pivot  int
+		source string
+		year   int
+	}{
+		{0, "12/31/68", 2068},
+		{0, "12/31/69", 1969},
+		{50, "12/31/49", 2049},
+		{50, "12/31/50", 1950},
+		{100, "12/31/99", 2099},
+		{50, "Monday, 02-Jan-56 15:04:05 UTC", 1956},
+		{50, "2026-01-02", 2026},
+	}
+	for _, tt := range tests {
+		got, _, err := NewParser(ParserWithYearPivot(tt.pivot), ParserWithDateOrder(DateOrderMDY)).Parse(tt.source)
+		if err != nil {
+			t.Errorf("Parse(%q) pivot %d: %v", tt.source, tt.pivot, err)
+			continue
+		}
+		if got.Year() != tt.year {
+			t.Errorf("Parse(%q) pivot %d = %v, want year %d", tt.source, tt.pivot, got, tt.year)
+		}
+	}
+	if _, _, err := NewParser(ParserWithYearPivot(101)).Parse("2026-01-02"); err == nil {
+		t.Error("Parse with pivot 101: want error")
+	}
+}
+
+func TestParserLocation(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewParser(ParserWithLocation(tokyo))
+	got, d, err := p.Parse("2026-03-02 09:00")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got.Location() != tokyo || got.Hour() != 9 {
+		t.Errorf("Parse = %v, want 09:00 in Asia/Tokyo", got)
+	}
+	if !strings.Contains(d.Zone, "Asia/Tokyo") {
+		t.Errorf("Parse zone = %q", d.Zone)
+	}
+	got, _, err = p.Parse("1704085262")
+	if err != nil || got.Location() != tokyo || !got.Equal(time.Unix(1704085262, 0)) {
+		t.Errorf("Parse(unix) = %v, %v", got, err)
+	}
+}
+
+func TestParserUnixAndStrict(t *testing.T) {
+	t.Parallel()
+	noUnix := NewParser(ParserWithUnixTimestamps(false))
+	if _, _, err := noUnix.Parse("1704085262"); err == nil {
+		t.Error("Parse(unix) without unix timestamps: want error")
+	}
+	if _, _, err := noUnix.Parse("20240101"); err != nil {
+		t.Errorf("Parse(20240101) without unix timestamps: %v", err)
+	}
+
+	strict := NewParser(ParserWithStrict(true))
+	for _, source := range []string{"now", "tomorrow", "next friday 9am", "now-7d/d", "8:30pm"} {
+		if _, _, err := strict.Parse(source); !errors.Is(err, ErrStrictParse) {
+			t.Errorf("strict Parse(%q): err = %v, want ErrStrictParse", source, err)
+		}
+	}
+	for _, source := range []string{"2026-03-02", "2026-03-02 14:00+1M", "13/02/2026", "1704085262"} {
+		if _, _, err := strict.Parse(source); err != nil {
+			t.Errorf("strict Parse(%q): %v", source, err)
+		}
+	}
+}
+
+func TestParserLocales(t *testing.T) {
+	// no t.Parallel: t.Setenv is process-wide
+	// a Parser's locales are its own, not those of the environment
+	t.Setenv(ParseLocalesEnvVar, "fr")
+	if _, _, err := NewParser().Parse("3 mars 2026"); err == nil {
+		t.Error("Parse(3 mars 2026) without locales: want error")
+	}
+	ref := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	p := NewParser(ParserWithLocales("de", "es"), ParserWithLocation(time.UTC), ParserWithClock(FixedClock(ref)))
+	tests := map[string]string{
+		"3. März 2026": "2026-03-03 00:00",
+		"Montag":       "2026-03-09 00:00",
+		"lunes 9:30":   "2026-03-09 09:30",
+	}
+	for source, want := range tests {
+		got, _, err := p.Parse(source)
+		if err != nil || got.Format("2006-01-02 15:04") != want {
+			t.Errorf("Parse(%q) = %v, %v; want %s", source, got, err, want)
+		}
+	}
+	// March 3, 2026 is a Tuesday
+	if _, _, err := p.Parse("Mittwoch, 3. März 2026"); err == nil || !strings.Contains(err.Error(), "is a Tuesday, not a Wednesday") {
+		t.Errorf("Parse of a contradicting weekday: err = %v", err)
+	}
+	if _, _, err := NewParser(ParserWithLocales("xx")).Parse("3 mars 2026"); err == nil || !strings.Contains(err.Error(), "parser locales") {
+		t.Errorf("Parse with an unknown locale: err = %v", err)
+	}
+	if _, _, err := NewParser(ParserWithLocales("all")).Parse("3 maart 2026"); err != nil {
+		t.Errorf("Parse(3 maart 2026) with all locales: %v", err)
+	}
+}
+
+func TestWithParser(t *testing.T) {
+	t.Parallel()
+	dmy := NewParser(ParserWithDateOrder(DateOrderDMY))
+
+	diff := NewDiff(DiffWithStart("01/02/2026"), DiffWithEnd("03/02/2026"), DiffWithParser(dmy))
+	_, d, err := diff.CalculateDiff()
+	if err != nil || d != 48*time.Hour {
+		t.Errorf("CalculateDiff = %v, %v, want 48h", d, err)
+	}
+
+	dur := NewDur(DurWithFrom("01/02/2026"), DurWithDur("1D"), DurWithOutputFormat("%Y-%m-%d"), DurWithParser(dmy))
+	got, err := dur.Add()
+	if err != nil || len(got) != 1 || got[0] != "2026-02-02" {
+		t.Errorf("Dur.Add = %q, %v, want 2026-02-02", got, err)
+	}
+
+	tzc := NewTimeZoneConverter(TimeZoneConverterWithParser(dmy))
+	converted, err := tzc.ConvertTimeZone("01/02/2026 12:00 UTC", "UTC")
+	if err != nil || converted.Month() != time.February || converted.Day() != 1 {
+		t.Errorf("ConvertTimeZone = %v, %v, want February 1", converted, err)
+	}
+}
@@ -0,0 +1,210 @@
+// range.go applies the interval operations of the range command to
+// intervals written as "start,end"
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RangeOperations lists the operations Range.Expand supports; union is the
+// same as merge
+var RangeOperations = []string{"merge", "union", "intersect", "subtract", "overlaps", "contains", "split"}
+
+// Range applies Operation to Items, each an interval in ParseInterval's
+// "start,end" form except for contains, whose first item is an interval
+// and whose others are date/times:
+//   - merge (or union) joins overlapping and touching intervals
+//   - intersect outputs the time shared by every interval, if any
+//   - subtract outputs the gaps left in the first interval by the others
+//   - overlaps outputs "true" or "false" for exactly two intervals
+//   - contains outputs "true" or "false" for each date/time
+//   - split divides a single interval into Parts equal parts, or into
+//     parts of length Step
+type Range struct {
+	Operation    string
+	Items        []string
+	Parts        int
+	Step         string
+	Brief        bool
+	OutputFormat string
+	Locale       string
+	Parser       *Parser
+	Clock        Clock
+}
+
+type OptionsRange func(*Range)
+
+// NewRange returns a Range configured with the given options
+func NewRange(options ...OptionsRange) *Range {
+	r := &Range{}
+	for _, opt := range options {
+		opt(r)
+	}
+	return r
+}
+
+func RangeWithOperation(operation string) OptionsRange {
+	return func(r *Range) {
+		r.Operation = operation
+	}
+}
+
+func RangeWithItems(items ...string) OptionsRange {
+	return func(r *Range) {
+		r.Items = items
+	}
+}
+
+// RangeWithParts makes split divide its interval into this many equal parts
+func RangeWithParts(parts int) OptionsRange {
+	return func(r *Range) {
+		r.Parts = parts
+	}
+}
+
+// RangeWithStep makes split divide its interval into parts of this length,
+// a duration in long, brief, or ISO 8601 form
+func RangeWithStep(step string) OptionsRange {
+	return func(r *Range) {
+		r.Step = step
+	}
+}
+
+// RangeWithBrief renders interval lengths in brief form, such as 1h30m
+func RangeWithBrief(brief bool) OptionsRange {
+	return func(r *Range) {
+		r.Brief = brief
+	}
+}
+
+func RangeWithOutputFormat(outputFormat string) OptionsRange {
+	return func(r *Range) {
+		r.OutputFormat = outputFormat
+	}
+}
+
+func RangeWithLocale(name string) OptionsRange {
+	return func(r *Range) {
+		r.Locale = name
+	}
+}
+
+// RangeWithParser parses the date/times in Items with the Parser's settings
+// instead of the environment's
+func RangeWithParser(p *Parser) OptionsRange {
+	return func(r *Range) {
+		r.Parser = p
+	}
+}
+
+// RangeWithClock sets the reference instant for relative date/times in
+// Items such as "now" or "tomorrow 9am"
+func RangeWithClock(c Clock) OptionsRange {
+	return func(r *Range) {
+		r.Clock = c
+	}
+}
+
+func (r *Range) String() string {
+	return fmt.Sprintf("Operation:%v Items:%v Parts:%v Step:%v Brief:%v OutputFormat:%v Locale:%v", r.Operation, r.Items, r.Parts, r.Step, r.Brief, r.OutputFormat, r.Locale)
+}
+
+// Expand applies the operation, returning one line per resulting interval,
+// formatted by Interval.Format, or one "true" or "false" line per answer
+func (r *Range) Expand() ([]string, error) {
+	operation := strings.ToLower(strings.TrimSpace(r.Operation))
+	if !slices.Contains(RangeOperations, operation) {
+		return nil, fmt.Errorf("unknown range operation %q: expected one of %s", r.Operation, strings.Join(RangeOperations, ", "))
+	}
+	if len(r.Items) == 0 {
+		return nil, fmt.Errorf("range %s: no intervals given", operation)
+	}
+	if operation == "contains" {
+		return r.contains()
+	}
+	intervals := make([]Interval, 0, len(r.Items))
+	p := r.Parser.withClock(r.Clock).orDefault()
+	for _, item := range r.Items {
+		iv, err := p.parseInterval(item)
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, iv)
+	}
+
+	var results []Interval
+	switch operation {
+	case "merge", "union":
+		results = MergeIntervals(intervals...)
+	case "intersect":
+		if common, ok := IntersectIntervals(intervals...); ok {
+			results = []Interval{common}
+		}
+	case "subtract":
+		results = SubtractIntervals(intervals[0], intervals[1:]...)
+	case "overlaps":
+		if len(intervals) != 2 {
+			return nil, fmt.Errorf("range overlaps needs exactly two intervals, not %d", len(intervals))
+		}
+		return []string{strconv.FormatBool(intervals[0].Overlaps(intervals[1]))}, nil
+	case "split":
+		var err error
+		if results, err = r.split(intervals); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := make([]string, 0, len(results))
+	for _, iv := range results {
+		line, err := iv.Format(r.OutputFormat, r.Brief, FormatWithLocale(r.Locale))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func (r *Range) contains() ([]string, error) {
+	if len(r.Items) < 2 {
+		return nil, fmt.Errorf("range contains needs an interval followed by one or more date/times")
+	}
+	p := r.Parser.withClock(r.Clock).orDefault()
+	iv, err := p.parseInterval(r.Items[0])
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]string, 0, len(r.Items)-1)
+	for _, item := range r.Items[1:] {
+		t, err := p.parse(item)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, strconv.FormatBool(iv.Contains(t)))
+	}
+	return lines, nil
+}
+
+func (r *Range) split(intervals []Interval) ([]Interval, error) {
+	if len(intervals) != 1 {
+		return nil, fmt.Errorf("range split needs exactly one interval, not %d", len(intervals))
+	}
+	switch {
+	case r.Parts != 0 && r.Step != "":
+		return nil, fmt.Errorf("range split takes either a number of parts or a step, not both")
+	case r.Parts != 0:
+		return intervals[0].SplitN(r.Parts)
+	case r.Step != "":
+		nanos, err := parseDurationNanos(r.Step)
+		if err != nil {
+			return nil, fmt.Errorf("invalid step %q: %w", r.Step, err)
+		}
+		return intervals[0].SplitEvery(time.Duration(nanos))
+	}
+	return nil, fmt.Errorf("range split needs a number of parts or a step")
+}
@@ -0,0 +1,224 @@
+// recur.go expands RFC 5545 recurrence rules (RRULEs), such as
+// "FREQ=MONTHLY;BYDAY=-1FR" for the last Friday of every month, into their
+// occurrences on the wall clock of a time zone.
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/rrule"
+)
+
+// DefaultRecurrenceCount is the number of occurrences returned for a rule
+// that has no end when neither a count nor an until date/time is given
+const DefaultRecurrenceCount = 10
+
+// Recurrence expands Rule from From. Rule is an RRULE value, optionally
+// with an "RRULE:" prefix, or iCalendar text with DTSTART, RRULE, and
+// EXDATE lines. From anchors the rule and overrides any DTSTART; when both
+// are absent the rule starts now. Count caps the number of occurrences and
+// Until is the latest one; ExDates are excluded occurrences, where a date
+// without a time excludes that whole day. Location is the time zone whose
+// wall clock the rule follows, overridden by a DTSTART's TZID.
+type Recurrence struct {
+	Rule         string
+	From         string
+	Until        string
+	Count        int
+	ExDates      []string
+	Location     *time.Location
+	OutputFormat string
+	Locale       string
+	Parser       *Parser
+	Clock        Clock
+}
+
+type OptionsRecurrence func(*Recurrence)
+
+// NewRecurrence returns a Recurrence configured with the given options
+func NewRecurrence(options ...OptionsRecurrence) *Recurrence {
+	r := &Recurrence{}
+	for _, opt := range options {
+		opt(r)
+	}
+	return r
+}
+
+func RecurrenceWithRule(rule string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Rule = rule
+	}
+}
+
+func RecurrenceWithFrom(from string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.From = from
+	}
+}
+
+func RecurrenceWithUntil(until string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Until = until
+	}
+}
+
+func RecurrenceWithCount(count int) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Count = count
+	}
+}
+
+// RecurrenceWithExDates excludes occurrences; a date without a time, such
+// as 2026-11-27, excludes every occurrence on that day
+func RecurrenceWithExDates(dates ...string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.ExDates = append(r.ExDates, dates...)
+	}
+}
+
+// RecurrenceWithLocation sets the time zone whose wall clock the rule
+// follows; the default is the local time zone
+func RecurrenceWithLocation(loc *time.Location) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Location = loc
+	}
+}
+
+func RecurrenceWithOutputFormat(outputFormat string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.OutputFormat = outputFormat
+	}
+}
+
+func RecurrenceWithLocale(name string) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Locale = name
+	}
+}
+
+// RecurrenceWithParser parses From, Until, and ExDates with the Parser's
+// settings instead of the environment's
+func RecurrenceWithParser(p *Parser) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Parser = p
+	}
+}
+
+// RecurrenceWithClock sets the reference instant for a relative From,
+// Until, or exdate, and the start of a rule with neither From nor DTSTART
+func RecurrenceWithClock(c Clock) OptionsRecurrence {
+	return func(r *Recurrence) {
+		r.Clock = c
+	}
+}
+
+func (r *Recurrence) String() string {
+	return fmt.Sprintf("Rule:%v From:%v Until:%v Count:%v ExDates:%v Location:%v OutputFormat:%v Locale:%v", r.Rule, r.From, r.Until, r.Count, r.ExDates, r.location(), r.OutputFormat, r.Locale)
+}
+
+// location returns the configured location, or time.Local when unset
+func (r *Recurrence) location() *time.Location {
+	if r.Location == nil {
+		return time.Local
+	}
+	return r.Location
+}
+
+// IsRecurrenceRule reports whether s looks like an RRULE rather than a
+// duration: it starts with "FREQ=", "RRULE:", or "DTSTART"
+func IsRecurrenceRule(s string) bool {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	return strings.HasPrefix(s, "FREQ=") || strings.HasPrefix(s, "RRULE:") || strings.HasPrefix(s, "DTSTART")
+}
+
+// Times returns the occurrences. A rule without COUNT or UNTIL returns
+// DefaultRecurrenceCount occurrences unless Count or Until is set.
+func (r *Recurrence) Times() ([]time.Time, error) {
+	if r.Count < 0 {
+		return nil, fmt.Errorf("count must not be negative: %d", r.Count)
+	}
+	if r.Count > maxUntilIterations {
+		return nil, fmt.Errorf("count must not exceed %d results: %d", maxUntilIterations, r.Count)
+	}
+	set, err := rrule.ParseSet(r.Rule, r.location())
+	if err != nil {
+		return nil, err
+	}
+	loc := set.Location
+	start := set.Start
+	p := r.Parser.withClock(r.Clock).orDefault()
+	if r.From != "" {
+		if start, err = p.parseIn(r.From, loc); err != nil {
+			return nil, err
+		}
+		start = start.In(loc)
+	} else if start.IsZero() {
+		start = p.now().Truncate(time.Second).In(loc)
+	}
+
+	opts := rrule.Options{Limit: r.Count, ExDates: set.ExDates, ExDays: set.ExDays}
+	if r.Until != "" {
+		if opts.Until, err = p.parseIn(r.Until, loc); err != nil {
+			return nil, err
+		}
+		if opts.Until.Before(start) {
+			return nil, fmt.Errorf("until date/time %q is before the start of the recurrence, %s", r.Until, start)
+		}
+	}
+	for _, ex := range r.ExDates {
+		if day, ok := parseDateOnly(ex, loc); ok {
+			opts.ExDays = append(opts.ExDays, day)
+			continue
+		}
+		t, err := p.parseIn(ex, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exdate %q: %w", ex, err)
+		}
+		opts.ExDates = append(opts.ExDates, t)
+	}
+	if opts.Limit == 0 {
+		if opts.Until.IsZero() && !set.Rule.Bounded() {
+			opts.Limit = DefaultRecurrenceCount
+		} else {
+			// one past the cap, so an over-long expansion is reported
+			// rather than silently truncated
+			opts.Limit = maxUntilIterations + 1
+		}
+	}
+	all, err := set.Rule.Expand(start, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(all) > maxUntilIterations {
+		return nil, fmt.Errorf("recurrence would produce more than %d results", maxUntilIterations)
+	}
+	return all, nil
+}
+
+// Expand returns the occurrences rendered with OutputFormat, or with
+// time.Time.String when it is empty
+func (r *Recurrence) Expand() ([]string, error) {
+	all, err := r.Times()
+	if err != nil {
+		return nil, err
+	}
+	return renderTimes(all, r.OutputFormat, FormatWithLocale(r.Locale))
+}
+
+// parseDateOnly parses a date without a time, YYYY-MM-DD or YYYYMMDD, as
+// midnight in loc; ok is false for anything else
+func parseDateOnly(source string, loc *time.Location) (time.Time, bool) {
+	source = strings.TrimSpace(source)
+	for _, layout := range []string{time.DateOnly, "20060102"} {
+		if len(source) != len(layout) {
+			continue
+		}
+		if t, err := time.ParseInLocation(layout, source, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
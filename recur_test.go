@@ -0,0 +1,100 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecurrence(t *testing.T) {
+	t.Parallel()
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	format := "%Y-%m-%d %H:%M %Z"
+	tests := []struct {
+		name    string
+		options []OptionsRecurrence
+		want    []string
+	}{
+		{"last friday", []OptionsRecurrence{RecurrenceWithRule("FREQ=MONTHLY;BYDAY=-1FR"), RecurrenceWithFrom("2026-10-18 08:00"), RecurrenceWithCount(3)},
+			[]string{"2026-10-30 08:00 EDT", "2026-11-27 08:00 EST", "2026-12-25 08:00 EST"}},
+		{"default count", []OptionsRecurrence{RecurrenceWithRule("RRULE:FREQ=DAILY"), RecurrenceWithFrom("2026-10-18 08:00")},
+			[]string{"2026-10-18 08:00 EDT", "2026-10-19 08:00 EDT", "2026-10-20 08:00 EDT", "2026-10-21 08:00 EDT", "2026-10-22 08:00 EDT",
+				"2026-10-23 08:00 EDT", "2026-10-24 08:00 EDT", "2026-10-25 08:00 EDT", "2026-10-26 08:00 EDT", "2026-10-27 08:00 EDT"}},
+		{"until", []OptionsRecurrence{RecurrenceWithRule("FREQ=WEEKLY;BYDAY=TU,TH"), RecurrenceWithFrom("2026-10-20 10:00"), RecurrenceWithUntil("2026-10-29")},
+			[]string{"2026-10-20 10:00 EDT", "2026-10-22 10:00 EDT", "2026-10-27 10:00 EDT"}},
+		{"exdates", []OptionsRecurrence{RecurrenceWithRule("FREQ=WEEKLY;BYDAY=TH;COUNT=4"), RecurrenceWithFrom("2026-11-19 09:00"),
+			RecurrenceWithExDates("2026-11-26", "2026-12-10 09:00")},
+			[]string{"2026-11-19 09:00 EST", "2026-12-03 09:00 EST"}},
+		{"location", []OptionsRecurrence{RecurrenceWithRule("FREQ=DAILY;COUNT=2"), RecurrenceWithFrom("2026-10-24 09:00"),
+			RecurrenceWithLocation(time.FixedZone("JST", 9*3600))},
+			[]string{"2026-10-24 09:00 JST", "2026-10-25 09:00 JST"}},
+		{"dtstart with tzid", []OptionsRecurrence{RecurrenceWithRule("DTSTART;TZID=Europe/London:20261023T090000\nRRULE:FREQ=DAILY;COUNT=3")},
+			[]string{"2026-10-23 09:00 BST", "2026-10-24 09:00 BST", "2026-10-25 09:00 GMT"}},
+	}
+	for _, tt := range tests {
+		options := append([]OptionsRecurrence{RecurrenceWithLocation(ny), RecurrenceWithOutputFormat(format)}, tt.options...)
+		got, err := NewRecurrence(options...).Expand()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s:\n got %v\nwant %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecurrenceInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		options []OptionsRecurrence
+		want    string
+	}{
+		{[]OptionsRecurrence{RecurrenceWithRule("FREQ=SOMETIMES")}, "unknown FREQ"},
+		{[]OptionsRecurrence{RecurrenceWithRule("")}, "no RRULE"},
+		{[]OptionsRecurrence{RecurrenceWithRule("FREQ=DAILY"), RecurrenceWithCount(-1)}, "negative"},
+		{[]OptionsRecurrence{RecurrenceWithRule("FREQ=DAILY"), RecurrenceWithFrom("2026-10-18"), RecurrenceWithUntil("2026-10-01")}, "before the start"},
+		{[]OptionsRecurrence{RecurrenceWithRule("FREQ=DAILY"), RecurrenceWithFrom("2026-10-18"), RecurrenceWithExDates("someday")}, "invalid exdate"},
+		{[]OptionsRecurrence{RecurrenceWithRule("FREQ=SECONDLY"), RecurrenceWithFrom("2026-10-18"), RecurrenceWithUntil("2026-11-18")}, "more than"},
+	}
+	for _, tt := range tests {
+		_, err := NewRecurrence(tt.options...).Expand()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: error = %v, want it to mention %q", NewRecurrence(tt.options...), err, tt.want)
+		}
+	}
+}
+
+func TestDurRecurrenceRule(t *testing.T) {
+	t.Parallel()
+	format := "%Y-%m-%d %a %H:%M"
+	tests := []struct {
+		options []OptionsDur
+		want    []string
+	}{
+		// from is an occurrence itself, so the result is the next one
+		{[]OptionsDur{DurWithFrom("2026-10-30 08:00"), DurWithDur("FREQ=MONTHLY;BYDAY=-1FR")},
+			[]string{"2026-11-27 Fri 08:00"}},
+		{[]OptionsDur{DurWithFrom("2026-10-18 08:00"), DurWithDur("FREQ=MONTHLY;BYDAY=-1FR"), DurWithRepeat(2)},
+			[]string{"2026-10-30 Fri 08:00", "2026-11-27 Fri 08:00"}},
+		{[]OptionsDur{DurWithFrom("2026-10-18 08:00"), DurWithDur("RRULE:FREQ=WEEKLY;BYDAY=MO,WE"), DurWithUntil("2026-10-28 08:00")},
+			[]string{"2026-10-19 Mon 08:00", "2026-10-21 Wed 08:00", "2026-10-26 Mon 08:00", "2026-10-28 Wed 08:00"}},
+	}
+	for _, tt := range tests {
+		got, err := NewDur(append(tt.options, DurWithOutputFormat(format))...).Add()
+		if err != nil {
+			t.Errorf("%v: %v", NewDur(tt.options...), err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%v:\n got %v\nwant %v", NewDur(tt.options...), got, tt.want)
+		}
+	}
+	if _, err := NewDur(DurWithFrom("2026-10-18"), DurWithDur("FREQ=DAILY")).Sub(); err == nil {
+		t.Error("subtracting a recurrence rule: expected an error")
+	}
+}
@@ -0,0 +1,40 @@
+// relative.go resolves natural-language relative dates such as
+// "3 days ago", "next friday", or "beginning of next quarter"
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/reldate"
+)
+
+// ParseRelativeDate resolves a natural-language relative date against ref,
+// in ref's location, returning the time and the canonical phrase that was
+// understood, such as "last monday of march 2026" for "last monday of
+// march", so a misreading is visible. It accepts:
+//   - shifts: "3 days ago", "in 2 weeks", "an hour ago", "2 hours from now"
+//   - days: "today", "tomorrow", "yesterday", "friday", "next friday",
+//     "last monday", "next month", "last monday of march",
+//     "first friday of next month"
+//   - period boundaries: "end of month", "beginning of next quarter",
+//     "start of the week" (weeks start on Monday)
+//   - times of day: "noon", "midnight", "9am", "9:30pm", "at 14:30", each
+//     today at that time when given alone
+//
+// combined as in "tomorrow 9am" or "3 days ago at noon". Days and longer
+// units move the calendar date and keep the wall clock; hours and shorter
+// add elapsed time, so "yesterday" is the same wall clock as "1 day ago".
+// A named day with no time of day starts at midnight, and "end of" is the
+// last nanosecond of the period.
+func ParseRelativeDate(source string, ref time.Time) (time.Time, string, error) {
+	t, phrase, claimed, err := reldate.Parse(source, ref)
+	if !claimed {
+		return time.Time{}, "", fmt.Errorf("not a relative date: %q", source)
+	}
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return t, phrase, nil
+}
@@ -0,0 +1,73 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	t.Parallel()
+	ref := time.Date(2026, 10, 18, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		source string
+		want   string
+		phrase string
+	}{
+		{"3 days ago", "2026-10-15 14:30:00", "3 days ago"},
+		{"in 2 weeks", "2026-11-01 14:30:00", "in 2 weeks"},
+		{"next friday", "2026-10-23 00:00:00", "next friday"},
+		{"last monday of march", "2026-03-30 00:00:00", "last monday of march 2026"},
+		{"tomorrow 9am", "2026-10-19 09:00:00", "tomorrow at 09:00"},
+		{"noon", "2026-10-18 12:00:00", "at 12:00"},
+		{"midnight", "2026-10-18 00:00:00", "at 00:00"},
+		{"9am", "2026-10-18 09:00:00", "at 09:00"},
+		{"9:30pm", "2026-10-18 21:30:00", "at 21:30"},
+		{"end of month", "2026-10-31 23:59:59.999999999", "end of this month"},
+		{"beginning of next quarter", "2027-01-01 00:00:00", "beginning of next quarter"},
+	}
+	for _, tt := range tests {
+		got, phrase, err := ParseRelativeDate(tt.source, ref)
+		if err != nil {
+			t.Errorf("ParseRelativeDate(%q) error = %v", tt.source, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05.999999999"); s != tt.want || phrase != tt.phrase || got.Location() != time.UTC {
+			t.Errorf("ParseRelativeDate(%q) = %s %v, %q; want %s UTC, %q", tt.source, s, got.Location(), phrase, tt.want, tt.phrase)
+		}
+	}
+}
+
+func TestParseRelativeDateInvalid(t *testing.T) {
+	t.Parallel()
+	ref := time.Date(2026, 10, 18, 14, 30, 0, 0, time.UTC)
+	tests := map[string]string{
+		"2026-10-18":  "not a relative date",
+		"Jan 2, 2024": "not a relative date",
+		"next fridya": `after "next"`,
+		"3 days":      `expected "ago" or "from now"`,
+	}
+	for source, want := range tests {
+		if _, _, err := ParseRelativeDate(source, ref); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseRelativeDate(%q) error = %v, want it to mention %q", source, err, want)
+		}
+	}
+}
+
+func TestRelativeDatesAcrossCommands(t *testing.T) {
+	t.Parallel()
+	dur := NewDur(DurWithFrom("beginning of next month"), DurWithDur("1 day"), DurWithOutputFormat("%d %H:%M"))
+	if got, err := dur.Add(); err != nil || !slices.Equal(got, []string{"02 00:00"}) {
+		t.Errorf("Dur.Add(beginning of next month + 1 day) = %v, %v; want [02 00:00]", got, err)
+	}
+	if got, err := Reformat("next friday at 3:30 pm", "%a %H:%M"); err != nil || got != "Fri 15:30" {
+		t.Errorf("Reformat(next friday at 3:30 pm) = %q, %v; want Fri 15:30", got, err)
+	}
+	if got, err := Reformat("9am", "%H:%M"); err != nil || got != "09:00" {
+		t.Errorf("Reformat(9am) = %q, %v; want 09:00", got, err)
+	}
+	if _, err := Reformat("next fridya", "%F"); err == nil || !strings.Contains(err.Error(), "invalid relative date") {
+		t.Errorf("Reformat(next fridya) error = %v, want an invalid relative date error", err)
+	}
+}
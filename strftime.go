@@ -0,0 +1,181 @@
+// strftime.go extends lestrrat-go/strftime with the specifiers every
+// formatting path shares: fractional seconds, milliseconds, the quarter,
+// ordinal day suffixes, and Unix time in seconds through nanoseconds; it
+// also localizes names and date representations with the built-in locales
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/locale"
+	"github.com/lestrrat-go/strftime"
+)
+
+// precisionSpecifiers rewrites the multi-character specifiers, which the
+// strftime package cannot register, to single-byte ones: %3N, %6N, and %9N
+// are %L, %f, and %N, while %3s, %6s, and %9s, Unix time in milliseconds,
+// microseconds, and nanoseconds, use bytes no format can otherwise contain
+var precisionSpecifiers = map[string]string{
+	"3N": "L",
+	"6N": "f",
+	"9N": "N",
+	"3s": "\x01",
+	"6s": "\x02",
+	"9s": "\x03",
+}
+
+// unixIn returns the appender for Unix time in units of 10^-digits seconds,
+// rounded down, computed without the overflow of time.Time.UnixNano
+// outside the years 1678 to 2262
+func unixIn(digits int) strftime.Appender {
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(9-digits)), nil)
+	return strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		n := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+		n.Add(n, big.NewInt(int64(t.Nanosecond())))
+		// Div rounds toward negative infinity for a positive divisor
+		return n.Div(n, divisor).Append(b, 10)
+	})
+}
+
+// ordinalSuffix returns "st", "nd", "rd", or "th" for the day of the month
+func ordinalSuffix(day int) string {
+	if day%100 >= 11 && day%100 <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+var extendedSpecifications = []strftime.Option{
+	strftime.WithUnixSeconds('s'),
+	strftime.WithMilliseconds('L'),
+	strftime.WithMicroseconds('f'),
+	strftime.WithSpecification('N', strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		s := strconv.Itoa(t.Nanosecond())
+		return append(append(b, strings.Repeat("0", 9-len(s))...), s...)
+	})),
+	strftime.WithSpecification('Q', strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return strconv.AppendInt(b, int64((t.Month()-1)/3+1), 10)
+	})),
+	strftime.WithSpecification('o', strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return append(b, ordinalSuffix(t.Day())...)
+	})),
+	strftime.WithSpecification('\x01', unixIn(3)),
+	strftime.WithSpecification('\x02', unixIn(6)),
+	strftime.WithSpecification('\x03', unixIn(9)),
+}
+
+// formatSettings holds the optional settings shared by FormatTime,
+// Reformat, and every type with an output format
+type formatSettings struct {
+	locale     string
+	layout     string
+	inputZone  string
+	outputZone string
+	converter  *TimeZoneConverter
+	parser     *Parser
+	clock      Clock
+}
+
+type OptionsFormat func(*formatSettings)
+
+// FormatWithLocale names the locale for month and weekday names, AM/PM, and
+// the %c, %x, and %X representations: a language code such as "fr" or a
+// POSIX locale name such as "de_DE.UTF-8". Locales returns the supported
+// languages; empty means English.
+func FormatWithLocale(name string) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.locale = name
+	}
+}
+
+// Locales returns the language codes of the built-in locales
+func Locales() []string {
+	return locale.Names()
+}
+
+// ParseLocale returns the language code of a built-in locale, such as "de"
+// for "de", "DE", or the POSIX locale name "de_DE.UTF-8"; "C" and "POSIX"
+// are English
+func ParseLocale(name string) (string, error) {
+	l, err := locale.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return l.Name, nil
+}
+
+// localeSpecifications returns the strftime options that render names with
+// l, and the composite specifiers with l's representations
+func localeSpecifications(l *locale.Locale) ([]strftime.Option, error) {
+	options := []strftime.Option{strftime.WithLocale(l)}
+	composites := []struct {
+		spec    byte
+		pattern string
+	}{
+		{'c', l.DateTime},
+		{'x', l.Date},
+		{'X', l.Time},
+		{'r', "%I:%M:%S %p"},
+		{'v', "%e-%b-%Y"},
+	}
+	for _, c := range composites {
+		f, err := strftime.New(c.pattern, strftime.WithLocale(l))
+		if err != nil {
+			return nil, fmt.Errorf("locale %s: %%%c: %w", l.Name, c.spec, err)
+		}
+		options = append(options, strftime.WithSpecification(c.spec, strftime.AppendFunc(f.FormatBuffer)))
+	}
+	return options, nil
+}
+
+// newStrftime compiles a strftime outputFormat with the extended specifiers
+// and any locale named by the options
+func newStrftime(outputFormat string, options ...OptionsFormat) (*strftime.Strftime, error) {
+	var settings formatSettings
+	for _, opt := range options {
+		opt(&settings)
+	}
+	specifications := extendedSpecifications
+	if settings.locale != "" {
+		l, err := locale.Lookup(settings.locale)
+		if err != nil {
+			return nil, err
+		}
+		localized, err := localeSpecifications(l)
+		if err != nil {
+			return nil, err
+		}
+		specifications = append(slices.Clip(extendedSpecifications), localized...)
+	}
+	var b strings.Builder
+	for i := 0; i < len(outputFormat); i++ {
+		b.WriteByte(outputFormat[i])
+		if outputFormat[i] != '%' || i+1 >= len(outputFormat) {
+			continue
+		}
+		if outputFormat[i+1] == '%' {
+			b.WriteByte('%')
+			i++
+		} else if i+2 < len(outputFormat) {
+			if spec, ok := precisionSpecifiers[outputFormat[i+1:i+3]]; ok {
+				b.WriteString(spec)
+				i += 2
+			}
+		}
+	}
+	return strftime.New(b.String(), specifications...)
+}
@@ -0,0 +1,99 @@
+package DateTimeMate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTimeExtendedSpecifiers(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2026, 11, 3, 14, 5, 6, 123456789, time.UTC)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%T.%L", "14:05:06.123"},
+		{"%T.%f", "14:05:06.123456"},
+		{"%T.%N", "14:05:06.123456789"},
+		{"%3N %6N %9N", "123 123456 123456789"},
+		{"%Y Q%Q", "2026 Q4"},
+		{"%B %-d%o", "November 3rd"},
+		{"%s %3s", "1793714706 1793714706123"},
+		{"%6s", "1793714706123456"},
+		{"%9s", "1793714706123456789"},
+		{"%%3N %%Q", "%3N %Q"},
+		{"%%%3N", "%123"},
+		{"100%", ""},
+	}
+	for _, tt := range tests {
+		got, err := FormatTime(tm, tt.format)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("FormatTime(%q) = %q, expected an error", tt.format, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("FormatTime(%q) = %q, %v; want %q", tt.format, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormatTimeUnixBeforeEpoch(t *testing.T) {
+	t.Parallel()
+	// half a second before the epoch rounds down to -1 second, as time.Time.Unix does
+	tm := time.Unix(-1, 500_000_000).UTC()
+	for format, want := range map[string]string{"%s": "-1", "%3s": "-500", "%9s": "-500000000", "%L": "500"} {
+		if got, err := FormatTime(tm, format); err != nil || got != want {
+			t.Errorf("FormatTime(%q) = %q, %v; want %q", format, got, err, want)
+		}
+	}
+	// far outside time.Time.UnixNano's range
+	tm = time.Date(3000, 1, 1, 0, 0, 0, 1, time.UTC)
+	if got, err := FormatTime(tm, "%9s"); err != nil || got != "32503680000000000001" {
+		t.Errorf("FormatTime(3000-01-01, %%9s) = %q, %v; want 32503680000000000001", got, err)
+	}
+}
+
+func TestOrdinalSuffix(t *testing.T) {
+	t.Parallel()
+	want := map[int]string{1: "st", 2: "nd", 3: "rd", 4: "th", 11: "th", 12: "th", 13: "th", 21: "st", 22: "nd", 23: "rd", 30: "th", 31: "st"}
+	for day, suffix := range want {
+		if got := ordinalSuffix(day); got != suffix {
+			t.Errorf("ordinalSuffix(%d) = %q, want %q", day, got, suffix)
+		}
+	}
+}
+
+func TestFormatTimeLocale(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2026, 7, 22, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		locale string
+		format string
+		want   string
+	}{
+		{"", "%A %-d %B %Y, %c", "Wednesday 22 July 2026, Wed Jul 22 15:04:05 2026"},
+		{"en", "%c | %x | %X", "Wed Jul 22 15:04:05 2026 | 07/22/26 | 15:04:05"},
+		{"fr", "%A %-d %B %Y", "mercredi 22 juillet 2026"},
+		{"fr_FR.UTF-8", "%a %d %b | %x", "mer. 22 juil. | 22/07/2026"},
+		{"de", "%A, %-d. %B %Y | %x", "Mittwoch, 22. Juli 2026 | 22.07.2026"},
+		{"es", "%c | %r", "mié 22 jul 2026 15:04:05 | 03:04:05 p. m."},
+		{"it", "%A %-d %B | %v", "mercoledì 22 luglio | 22-lug-2026"},
+		{"pt", "%A, %-d de %B", "quarta-feira, 22 de julho"},
+		{"nl", "%a %-d %b %Y | %x", "wo 22 jul 2026 | 22-07-2026"},
+	}
+	for _, tt := range tests {
+		got, err := FormatTime(tm, tt.format, FormatWithLocale(tt.locale))
+		if err != nil || got != tt.want {
+			t.Errorf("FormatTime(%q, locale %q) = %q, %v; want %q", tt.format, tt.locale, got, err, tt.want)
+		}
+	}
+	if _, err := Reformat("2026-07-22", "%A", FormatWithLocale("xx")); err == nil {
+		t.Error("Reformat with locale xx expected an error")
+	}
+	lines, err := NewWeek(WeekWithSource("2026-W07"), WeekWithRules(ISOWeekRule), WeekWithLocale("de")).Expand()
+	if err != nil || len(lines) != 1 || lines[0] != "iso  2026-W07  2026-02-09 Mo - 2026-02-15 So" {
+		t.Errorf("Week.Expand with locale de = %q, %v", lines, err)
+	}
+}
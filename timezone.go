@@ -3,6 +3,7 @@ package DateTimeMate
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -24,11 +25,14 @@ var (
 // supplies fixed UTC offsets for abbreviations such as EST or JST that are
 // not resolvable as IANA zone names, Aliases maps abbreviations to IANA
 // zone names and takes precedence over every other resolution, and
-// AllowPre1970 permits conversions of date/times before 1970
+// AllowPre1970 permits conversions of date/times before 1970; Parser, when
+// set, reads the source date/times, and Clock is their reference instant
 type TimeZoneConverter struct {
 	ZoneAbbrevs  map[string]ZoneDefinition
 	Aliases      map[string]string
 	AllowPre1970 bool
+	Parser       *Parser
+	Clock        Clock
 }
 
 type OptionsTimeZoneConverter func(*TimeZoneConverter)
@@ -60,6 +64,27 @@ func TimeZoneConverterWithAllowPre1970(allow bool) OptionsTimeZoneConverter {
 	}
 }
 
+// TimeZoneConverterWithParser parses source date/times with the Parser's
+// settings; a trailing zone still overrides its Location
+func TimeZoneConverterWithParser(p *Parser) OptionsTimeZoneConverter {
+	return func(tzc *TimeZoneConverter) {
+		tzc.Parser = p
+	}
+}
+
+// TimeZoneConverterWithClock sets the reference instant for relative source
+// date/times and the date of a bare time of day
+func TimeZoneConverterWithClock(c Clock) OptionsTimeZoneConverter {
+	return func(tzc *TimeZoneConverter) {
+		tzc.Clock = c
+	}
+}
+
+// parser returns the Parser reading source date/times
+func (c *TimeZoneConverter) parser() *Parser {
+	return c.Parser.withClock(c.Clock)
+}
+
 // ParseZoneAliases parses pipe-delimited abbreviation overrides such as
 // "IST=Asia/Jerusalem|CST=Asia/Shanghai"; keys are uppercased and every
 // value must name a valid IANA time zone
@@ -117,6 +142,114 @@ func (c *TimeZoneConverter) ConvertTimeZone(sourceTime string, targetZone string
 	return parsed.In(targetLoc), nil
 }
 
+// ResolveLocation resolves a time zone the way ConvertTimeZone resolves
+// its target: an alias, an abbreviation, an IANA name (case-insensitive),
+// or a UTC offset
+func (c *TimeZoneConverter) ResolveLocation(zone string) (*time.Location, error) {
+	zone = strings.TrimSpace(zone)
+	if zone == "" {
+		return nil, ErrEmptyInput
+	}
+	loc, err := c.resolveLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve timezone %q: %w", zone, err)
+	}
+	return loc, nil
+}
+
+// ConvertTime converts an already-parsed time to the target time zone,
+// resolved as ConvertTimeZone resolves it and with the same refusal of
+// times before 1970 unless AllowPre1970 is set
+func (c *TimeZoneConverter) ConvertTime(t time.Time, targetZone string) (time.Time, error) {
+	convert, err := c.converterTo(targetZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return convert(t)
+}
+
+// converterTo resolves targetZone once and returns the function converting
+// times to it, so a series of results is resolved only once
+func (c *TimeZoneConverter) converterTo(targetZone string) (func(time.Time) (time.Time, error), error) {
+	targetZone = strings.TrimSpace(targetZone)
+	if targetZone == "" {
+		return nil, ErrEmptyInput
+	}
+	loc, err := c.resolveLocation(targetZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve target timezone %q: %w", targetZone, err)
+	}
+	return func(t time.Time) (time.Time, error) {
+		if t.Year() < 1970 && !c.AllowPre1970 {
+			return time.Time{}, fmt.Errorf("%w: %s", ErrPre1970, t.Format("2006-01-02 15:04:05 -0700 MST"))
+		}
+		return t.In(loc), nil
+	}, nil
+}
+
+// defaultTimeZoneConverter returns the converter dtmate tz uses: the
+// built-in abbreviations of LoadZoneDefinitions and the aliases of
+// ZoneAliasesEnvVar
+func defaultTimeZoneConverter() (*TimeZoneConverter, error) {
+	aliases, err := ParseZoneAliases(os.Getenv(ZoneAliasesEnvVar))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ZoneAliasesEnvVar, err)
+	}
+	return NewTimeZoneConverter(TimeZoneConverterWithZoneAbbrevs(LoadZoneDefinitions()), TimeZoneConverterWithAliases(aliases)), nil
+}
+
+// ResolveZone resolves a time zone the way dtmate tz does: a
+// TimeZoneConverter with the built-in abbreviations of LoadZoneDefinitions
+// and the aliases of ZoneAliasesEnvVar
+func ResolveZone(zone string) (*time.Location, error) {
+	c, err := defaultTimeZoneConverter()
+	if err != nil {
+		return nil, err
+	}
+	return c.ResolveLocation(zone)
+}
+
+// FormatWithOutputZone converts each time to zone before it is rendered,
+// with the TimeZoneConverter given by FormatWithTimeZoneConverter, or else
+// one with the built-in abbreviations and the aliases of ZoneAliasesEnvVar;
+// a time before 1970 is an error unless that converter allows it
+func FormatWithOutputZone(zone string) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.outputZone = zone
+	}
+}
+
+// FormatWithTimeZoneConverter sets the converter used by FormatWithOutputZone
+func FormatWithTimeZoneConverter(c *TimeZoneConverter) OptionsFormat {
+	return func(fs *formatSettings) {
+		fs.converter = c
+	}
+}
+
+// newOutputConverter returns the function converting times to the zone of
+// FormatWithOutputZone, or nil when none is given
+func newOutputConverter(options ...OptionsFormat) (func(time.Time) (time.Time, error), error) {
+	var settings formatSettings
+	for _, opt := range options {
+		opt(&settings)
+	}
+	if settings.outputZone == "" {
+		return nil, nil
+	}
+	c := settings.converter
+	if c == nil {
+		var err error
+		if c, err = defaultTimeZoneConverter(); err != nil {
+			return nil, err
+		}
+	}
+	convert, err := c.converterTo(settings.outputZone)
+	if err != nil {
+		return nil, fmt.Errorf("output zone: %w", err)
+	}
+	return convert, nil
+}
+
 // Warnings reports the ambiguous zone abbreviations a conversion of the
 // given source and target would rely on, excluding any overridden by an
 // alias; each message names ZoneAliasesEnvVar so the user can override
@@ -196,7 +329,7 @@ func (c *TimeZoneConverter) parseSourceTime(input string) (time.Time, error) {
 			return c.parseWallClockIn(input, wall, token, loc)
 		}
 	}
-	return parseDateTimeOrUnix(input)
+	return c.parser().parse(input)
 }
 
 // parseWallClockIn interprets the wall clock preceding a trailing zone
@@ -214,7 +347,7 @@ func (c *TimeZoneConverter) parseWallClockIn(input, wall, zone string, loc *time
 		}
 		return parseIntegerDateTime(wall, loc)
 	}
-	t, err := parseDateTimeIn(wall, loc)
+	t, _, err := c.parser().orDefault().detectDateTimeIn(wall, loc)
 	if err != nil {
 		return time.Time{}, err
 	}
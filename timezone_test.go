@@ -888,3 +888,52 @@ func BenchmarkComplexScenarios(b *testing.B) {
 		})
 	}
 }
+
+func TestResolveZone(t *testing.T) {
+	t.Setenv(ZoneAliasesEnvVar, "HQ=Asia/Kolkata")
+	for zone, want := range map[string]string{
+		"hq":               "Asia/Kolkata",
+		"america/new_york": "America/New_York",
+		"JST":              "JST",
+		"3600":             "UTC+01:00",
+	} {
+		loc, err := ResolveZone(zone)
+		assert.NoError(t, err)
+		if err == nil {
+			assert.Equal(t, want, loc.String())
+		}
+	}
+	_, err := ResolveZone("Nowhere/Zone")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+
+	t.Setenv(ZoneAliasesEnvVar, "garbage")
+	_, err = ResolveZone("UTC")
+	assert.ErrorContains(t, err, ZoneAliasesEnvVar)
+}
+
+func TestConvertTime(t *testing.T) {
+	conv := NewTimeZoneConverter(
+		TimeZoneConverterWithZoneAbbrevs(LoadZoneDefinitions()),
+		TimeZoneConverterWithAliases(map[string]string{"HQ": "Asia/Tokyo"}))
+	noon := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	for zone, want := range map[string]string{
+		"hq":            "2026-07-01 21:00:00 JST",
+		"Europe/London": "2026-07-01 13:00:00 BST",
+		"CET":           "2026-07-01 13:00:00 CET",
+	} {
+		result, err := conv.ConvertTime(noon, zone)
+		assert.NoError(t, err)
+		assert.Equal(t, want, result.Format("2006-01-02 15:04:05 MST"))
+	}
+	_, err := conv.ConvertTime(noon, "Nowhere/Zone")
+	assert.ErrorIs(t, err, ErrInvalidTimezone)
+	_, err = conv.ConvertTime(noon, " ")
+	assert.ErrorIs(t, err, ErrEmptyInput)
+
+	old := time.Date(1965, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = conv.ConvertTime(old, "UTC")
+	assert.ErrorIs(t, err, ErrPre1970)
+	conv.AllowPre1970 = true
+	_, err = conv.ConvertTime(old, "UTC")
+	assert.NoError(t, err)
+}
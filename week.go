@@ -0,0 +1,257 @@
+// week.go numbers the weeks of a year under ISO 8601, US, or custom week
+// rules
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jftuga/DateTimeMate/internal/datecalc"
+	"github.com/jftuga/DateTimeMate/internal/holiday"
+)
+
+// WeekRule numbers the weeks of a year: every week starts on FirstDay, and
+// week 1 is the first week holding at least MinDays (1-7) days of the new
+// year; days before it belong to the last week of the previous week-year
+type WeekRule struct {
+	FirstDay time.Weekday
+	MinDays  int
+}
+
+var (
+	// ISOWeekRule is ISO 8601: weeks start on Monday and week 1 holds the
+	// year's first Thursday
+	ISOWeekRule = WeekRule{FirstDay: time.Monday, MinDays: 4}
+	// USWeekRule weeks start on Sunday and week 1 holds January 1
+	USWeekRule = WeekRule{FirstDay: time.Sunday, MinDays: 1}
+)
+
+// ParseWeekRule returns the week rule named "iso" or "us", case-insensitive
+func ParseWeekRule(name string) (WeekRule, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "iso":
+		return ISOWeekRule, nil
+	case "us":
+		return USWeekRule, nil
+	}
+	return WeekRule{}, fmt.Errorf("week rule must be iso or us, not %q", name)
+}
+
+// NewWeekRule returns a custom week rule; firstDay is a weekday name, full
+// or abbreviated, and minDays must be between 1 and 7
+func NewWeekRule(firstDay string, minDays int) (WeekRule, error) {
+	day, err := holiday.ParseWeekday(firstDay)
+	if err != nil {
+		return WeekRule{}, err
+	}
+	rule := WeekRule{FirstDay: day, MinDays: minDays}
+	return rule, rule.validate()
+}
+
+// String returns "iso", "us", or the custom rule's first day and minimum
+// days, such as "sat/1"
+func (r WeekRule) String() string {
+	switch r {
+	case ISOWeekRule:
+		return "iso"
+	case USWeekRule:
+		return "us"
+	}
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.FirstDay.String()[:3]), r.MinDays)
+}
+
+func (r WeekRule) validate() error {
+	if r.FirstDay < time.Sunday || r.FirstDay > time.Saturday {
+		return fmt.Errorf("invalid first day of the week: %d", r.FirstDay)
+	}
+	if r.MinDays < 1 || r.MinDays > 7 {
+		return fmt.Errorf("minimum days in week 1 must be between 1 and 7: %d", r.MinDays)
+	}
+	return nil
+}
+
+func (r WeekRule) calc() datecalc.WeekRule {
+	return datecalc.WeekRule{FirstDay: r.FirstDay, MinDays: r.MinDays}
+}
+
+// Number returns the week-year and week number of t's calendar date; the
+// week-year differs from t's year for days at either end of the year
+func (r WeekRule) Number(t time.Time) (year, week int) {
+	return r.calc().Week(t)
+}
+
+// WeeksIn returns the number of weeks in the week-year, 52 or 53
+func (r WeekRule) WeeksIn(year int) int {
+	return r.calc().WeeksIn(year)
+}
+
+// Bounds returns the first and last days of the given week, at midnight in
+// loc, erroring when the year has no such week
+func (r WeekRule) Bounds(year, week int, loc *time.Location) (start, end time.Time, err error) {
+	if err := r.validate(); err != nil {
+		return start, end, err
+	}
+	if weeks := r.WeeksIn(year); week < 1 || week > weeks {
+		return start, end, fmt.Errorf("week %d is out of range: %d has weeks 1 to %d under the %s rule", week, year, weeks, r)
+	}
+	start = r.calc().WeekStart(year, week, loc)
+	return start, start.AddDate(0, 0, 6), nil
+}
+
+// weekSpecRegexp matches a week without a weekday, such as "2026-W07"
+var weekSpecRegexp = regexp.MustCompile(`^(\d{4})-?[Ww](\d{2})$`)
+
+// DefaultWeekOutputFormat renders the first and last days of a week
+const DefaultWeekOutputFormat = "%Y-%m-%d %a"
+
+// Week describes the week of Source under each of Rules, ISOWeekRule and
+// USWeekRule when empty. Source is either a week, such as "2026-W07", which
+// is numbered by each rule in turn, or any date/time, whose week under each
+// rule is found.
+type Week struct {
+	Source       string
+	Rules        []WeekRule
+	OutputFormat string
+	Locale       string
+	Parser       *Parser
+	Clock        Clock
+}
+
+type OptionsWeek func(*Week)
+
+// WeekInfo is one week under a rule: Year and Number label it, as in
+// "2026-W07", and Start and End are its first and last days
+type WeekInfo struct {
+	Rule   WeekRule
+	Year   int
+	Number int
+	Start  time.Time
+	End    time.Time
+}
+
+// String labels the week, such as "2026-W07"
+func (wi WeekInfo) String() string {
+	return fmt.Sprintf("%04d-W%02d", wi.Year, wi.Number)
+}
+
+// NewWeek returns a Week configured with the given options
+func NewWeek(options ...OptionsWeek) *Week {
+	w := &Week{}
+	for _, opt := range options {
+		opt(w)
+	}
+	return w
+}
+
+func WeekWithSource(source string) OptionsWeek {
+	return func(w *Week) {
+		w.Source = source
+	}
+}
+
+func WeekWithRules(rules ...WeekRule) OptionsWeek {
+	return func(w *Week) {
+		w.Rules = rules
+	}
+}
+
+func WeekWithOutputFormat(outputFormat string) OptionsWeek {
+	return func(w *Week) {
+		w.OutputFormat = outputFormat
+	}
+}
+
+func WeekWithLocale(name string) OptionsWeek {
+	return func(w *Week) {
+		w.Locale = name
+	}
+}
+
+// WeekWithParser parses Source with the Parser's settings instead of the
+// environment's
+func WeekWithParser(p *Parser) OptionsWeek {
+	return func(w *Week) {
+		w.Parser = p
+	}
+}
+
+// WeekWithClock sets the reference instant for a relative Source such as
+// "today" or "next friday"
+func WeekWithClock(c Clock) OptionsWeek {
+	return func(w *Week) {
+		w.Clock = c
+	}
+}
+
+func (w *Week) String() string {
+	return fmt.Sprintf("Source:%v Rules:%v OutputFormat:%v Locale:%v", w.Source, w.Rules, w.OutputFormat, w.Locale)
+}
+
+// Weeks returns the week of Source under each rule
+func (w *Week) Weeks() ([]WeekInfo, error) {
+	rules := w.Rules
+	if len(rules) == 0 {
+		rules = []WeekRule{ISOWeekRule, USWeekRule}
+	}
+	source := strings.TrimSpace(w.Source)
+	var all []WeekInfo
+	if m := weekSpecRegexp.FindStringSubmatch(source); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		number, _ := strconv.Atoi(m[2])
+		for _, rule := range rules {
+			start, end, err := rule.Bounds(year, number, time.Local)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, WeekInfo{Rule: rule, Year: year, Number: number, Start: start, End: end})
+		}
+		return all, nil
+	}
+	t, err := w.Parser.withClock(w.Clock).parse(source)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, err
+		}
+		year, number := rule.Number(t)
+		start, end, err := rule.Bounds(year, number, t.Location())
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, WeekInfo{Rule: rule, Year: year, Number: number, Start: start, End: end})
+	}
+	return all, nil
+}
+
+// Expand returns one line per rule, such as
+// "iso  2026-W07  2026-02-09 Mon - 2026-02-15 Sun", with the first and last
+// days rendered with OutputFormat, or DefaultWeekOutputFormat when empty
+func (w *Week) Expand() ([]string, error) {
+	all, err := w.Weeks()
+	if err != nil {
+		return nil, err
+	}
+	outputFormat := w.OutputFormat
+	if outputFormat == "" {
+		outputFormat = DefaultWeekOutputFormat
+	}
+	width := 0
+	for _, wi := range all {
+		width = max(width, len(wi.Rule.String()))
+	}
+	lines := make([]string, 0, len(all))
+	for _, wi := range all {
+		days, err := renderTimes([]time.Time{wi.Start, wi.End}, outputFormat, FormatWithLocale(w.Locale))
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %s  %s - %s", width, wi.Rule, wi, days[0], days[1]))
+	}
+	return lines, nil
+}
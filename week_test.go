@@ -0,0 +1,89 @@
+package DateTimeMate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWeekExpand(t *testing.T) {
+	t.Parallel()
+	saturday, err := NewWeekRule("sat", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		week  *Week
+		lines []string
+	}{
+		{
+			"week spec under the default rules",
+			NewWeek(WeekWithSource("2026-W07")),
+			[]string{
+				"iso  2026-W07  2026-02-09 Mon - 2026-02-15 Sun",
+				"us   2026-W07  2026-02-08 Sun - 2026-02-14 Sat",
+			},
+		},
+		{
+			"date at the end of the year",
+			NewWeek(WeekWithSource("2026-12-31")),
+			[]string{
+				"iso  2026-W53  2026-12-28 Mon - 2027-01-03 Sun",
+				"us   2027-W01  2026-12-27 Sun - 2027-01-02 Sat",
+			},
+		},
+		{
+			"custom rule and output format",
+			NewWeek(WeekWithSource("2026-01-02"), WeekWithRules(saturday), WeekWithOutputFormat("%m/%d")),
+			[]string{"sat/1  2026-W01  12/27 - 01/02"},
+		},
+		{
+			"week date input",
+			NewWeek(WeekWithSource("2026-W07-3"), WeekWithRules(USWeekRule)),
+			[]string{"us  2026-W07  2026-02-08 Sun - 2026-02-14 Sat"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := tt.week.Expand()
+		if err != nil || !slices.Equal(got, tt.lines) {
+			t.Errorf("%s: Expand() = %q, %v; want %q", tt.name, got, err, tt.lines)
+		}
+	}
+}
+
+func TestWeekNumber(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC)
+	if year, week := ISOWeekRule.Number(date); year != 2026 || week != 53 {
+		t.Errorf("ISOWeekRule.Number(2027-01-01) = %d-W%02d, want 2026-W53", year, week)
+	}
+	if year, week := USWeekRule.Number(date); year != 2027 || week != 1 {
+		t.Errorf("USWeekRule.Number(2027-01-01) = %d-W%02d, want 2027-W01", year, week)
+	}
+}
+
+func TestWeekInvalid(t *testing.T) {
+	t.Parallel()
+	tests := map[*Week]string{
+		NewWeek(WeekWithSource("2026-W54")):                                         "week 54 is out of range",
+		NewWeek(WeekWithSource("2026-W53"), WeekWithRules(USWeekRule)):              "2026 has weeks 1 to 52 under the us rule",
+		NewWeek(WeekWithSource("2026-W07"), WeekWithRules(WeekRule{MinDays: 8})):    "between 1 and 7",
+		NewWeek(WeekWithSource("not a date")):                                       "not a date",
+		NewWeek(WeekWithSource("2026-02-11"), WeekWithRules(WeekRule{FirstDay: 9})): "invalid first day",
+	}
+	for week, want := range tests {
+		if _, err := week.Expand(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%v: Expand() error = %v, want it to mention %q", week, err, want)
+		}
+	}
+	for _, name := range []string{"", "iso8601", "sunday"} {
+		if _, err := ParseWeekRule(name); err == nil {
+			t.Errorf("ParseWeekRule(%q) succeeded, want an error", name)
+		}
+	}
+	if _, err := NewWeekRule("funday", 4); err == nil {
+		t.Error("NewWeekRule(funday) succeeded, want an error")
+	}
+}
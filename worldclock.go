@@ -0,0 +1,68 @@
+// worldclock.go converts one date/time into several time zones at once,
+// reporting each zone's wall clock, offset, abbreviation, daylight saving
+// time, and how many calendar days it is ahead of or behind the source
+
+package DateTimeMate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ZoneTime is a world clock row: the source instant in Zone, as given, with
+// its abbreviation and offset in seconds east of UTC, whether daylight
+// saving time is in effect there, and DayShift, the calendar days its date
+// is ahead of (+1) or behind (-1) the source's own date
+type ZoneTime struct {
+	Zone         string
+	Time         time.Time
+	Abbreviation string
+	Offset       int
+	DST          bool
+	DayShift     int
+}
+
+func (zt ZoneTime) String() string {
+	return fmt.Sprintf("Zone:%v Time:%v Abbreviation:%v Offset:%v DST:%v DayShift:%v", zt.Zone, zt.Time, zt.Abbreviation, zt.Offset, zt.DST, zt.DayShift)
+}
+
+// ConvertToZones converts a date/time string into each of the target time
+// zones, resolved as ConvertTimeZone resolves its target; the source is
+// parsed once, so every row shows the same instant, and is returned with
+// the rows, in the source's own zone
+func (c *TimeZoneConverter) ConvertToZones(sourceTime string, targetZones []string) (time.Time, []ZoneTime, error) {
+	sourceTime = strings.TrimSpace(sourceTime)
+	if sourceTime == "" || len(targetZones) == 0 {
+		return time.Time{}, nil, ErrEmptyInput
+	}
+	source, err := c.parseSourceTime(sourceTime)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to parse source time: %w", err)
+	}
+	rows := make([]ZoneTime, 0, len(targetZones))
+	for _, zone := range targetZones {
+		t, err := c.ConvertTime(source, zone)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		abbreviation, offset := t.Zone()
+		rows = append(rows, ZoneTime{
+			Zone:         strings.TrimSpace(zone),
+			Time:         t,
+			Abbreviation: abbreviation,
+			Offset:       offset,
+			DST:          t.IsDST(),
+			DayShift:     calendarDays(source, t),
+		})
+	}
+	return source, rows, nil
+}
+
+// calendarDays returns the number of calendar days from the date of from,
+// in its zone, to the date of to, in its zone
+func calendarDays(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
@@ -0,0 +1,58 @@
+package DateTimeMate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConvertToZones(t *testing.T) {
+	t.Parallel()
+	conv := NewTimeZoneConverter(
+		TimeZoneConverterWithZoneAbbrevs(LoadZoneDefinitions()),
+		TimeZoneConverterWithAliases(map[string]string{"HQ": "America/Denver"}))
+	source, rows, err := conv.ConvertToZones("2026-10-18 02:00:00 UTC", []string{"Europe/London", "Asia/Kolkata", " hq ", "Pacific/Kiritimati"})
+	if err != nil {
+		t.Fatalf("ConvertToZones: %v", err)
+	}
+	if want := time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC); !source.Equal(want) {
+		t.Errorf("source = %v, want %v", source, want)
+	}
+	want := []struct {
+		zone, local, abbreviation string
+		offset                    int
+		dst                       bool
+		dayShift                  int
+	}{
+		{"Europe/London", "2026-10-18 03:00", "BST", 3600, true, 0},
+		{"Asia/Kolkata", "2026-10-18 07:30", "IST", 19800, false, 0},
+		{"hq", "2026-10-17 20:00", "MDT", -21600, true, -1},
+		{"Pacific/Kiritimati", "2026-10-18 16:00", "+14", 50400, false, 0},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("ConvertToZones returned %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		row := rows[i]
+		if row.Zone != w.zone || row.Time.Format("2006-01-02 15:04") != w.local || row.Abbreviation != w.abbreviation ||
+			row.Offset != w.offset || row.DST != w.dst || row.DayShift != w.dayShift {
+			t.Errorf("row %d = %v, want %+v", i, row, w)
+		}
+	}
+
+	// a day ahead of the source's own date
+	_, rows, err = conv.ConvertToZones("2026-10-18 20:00:00 UTC", []string{"Asia/Tokyo"})
+	if err != nil || rows[0].DayShift != 1 {
+		t.Errorf("ConvertToZones to Tokyo = %v, %v, want a day shift of +1", rows, err)
+	}
+
+	if _, _, err := conv.ConvertToZones("2026-10-18 02:00:00 UTC", []string{"UTC", "Nowhere/Zone"}); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("ConvertToZones with an unknown zone: err = %v, want ErrInvalidTimezone", err)
+	}
+	if _, _, err := conv.ConvertToZones("1960-01-01 00:00:00 UTC", []string{"UTC"}); !errors.Is(err, ErrPre1970) {
+		t.Errorf("ConvertToZones before 1970: err = %v, want ErrPre1970", err)
+	}
+	if _, _, err := conv.ConvertToZones("2026-10-18", nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("ConvertToZones without zones: err = %v, want ErrEmptyInput", err)
+	}
+}